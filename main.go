@@ -1,8 +1,11 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"log"
 
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 	"github.com/terraform-provider-openstack/terraform-provider-openstack/v3/openstack"
 )
@@ -16,9 +19,16 @@ func main() {
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
+	// The ephemeral resources are served by the plugin framework, which is
+	// muxed with the SDK provider.
+	muxServer, err := tf5muxserver.NewMuxServer(context.Background(), openstack.ProviderServers()...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	plugin.Serve(&plugin.ServeOpts{
-		Debug:        debugMode,
-		ProviderAddr: providerAddr,
-		ProviderFunc: openstack.Provider,
+		Debug:            debugMode,
+		ProviderAddr:     providerAddr,
+		GRPCProviderFunc: muxServer.ProviderServer,
 	})
 }
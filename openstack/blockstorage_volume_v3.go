@@ -6,8 +6,10 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/limits"
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-provider-openstack/utils/v2/hashcode"
@@ -138,3 +140,22 @@ func resourceBlockStorageVolumeV3SchedulerHints(schedulerHintsRaw map[string]any
 
 	return schedulerHints
 }
+
+// blockStorageV3QuotaUsage returns a function, which returns the current
+// block storage quota usage of the project from the limits API.
+func blockStorageV3QuotaUsage(client *gophercloud.ServiceClient) func(context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		l, err := limits.Get(ctx, client).Extract()
+		if err != nil {
+			return "", err
+		}
+
+		a := l.Absolute
+
+		return strings.Join([]string{
+			quotaUsage("volumes", a.TotalVolumesUsed, a.MaxTotalVolumes, ""),
+			quotaUsage("gigabytes", a.TotalGigabytesUsed, a.MaxTotalVolumeGigabytes, " GiB"),
+			quotaUsage("snapshots", a.TotalSnapshotsUsed, a.MaxTotalSnapshots, ""),
+		}, "\n"), nil
+	}
+}
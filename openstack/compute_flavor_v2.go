@@ -1,6 +1,12 @@
 package openstack
 
-import "github.com/gophercloud/gophercloud/v2/openstack/compute/v2/flavors"
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/flavors"
+	flavorsutils "github.com/gophercloud/utils/v2/openstack/compute/v2/flavors"
+)
 
 const computeV2FlavorDescriptionMicroversion = "2.55"
 
@@ -12,3 +18,36 @@ func expandComputeFlavorV2ExtraSpecs(raw map[string]any) flavors.ExtraSpecsOpts
 
 	return extraSpecs
 }
+
+// computeFlavorV2IDFromName returns the ID of the flavor with the given name
+// using the lookup cache of the provider.
+func computeFlavorV2IDFromName(ctx context.Context, cache *lookupCache, client *gophercloud.ServiceClient, name string) (string, error) {
+	v, err := cache.lookup(client, lookupCacheFlavor, "name", name, func() (string, string, any, error) {
+		id, err := flavorsutils.IDFromName(ctx, client, name)
+
+		return id, name, id, err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return v.(string), nil
+}
+
+// computeFlavorV2Name returns the name of the flavor with the given ID using
+// the lookup cache of the provider.
+func computeFlavorV2Name(ctx context.Context, cache *lookupCache, client *gophercloud.ServiceClient, id string) (string, error) {
+	v, err := cache.lookup(client, lookupCacheFlavor, "id", id, func() (string, string, any, error) {
+		flavor, err := flavors.Get(ctx, client, id).Extract()
+		if err != nil {
+			return "", "", nil, err
+		}
+
+		return flavor.ID, flavor.Name, flavor.Name, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return v.(string), nil
+}
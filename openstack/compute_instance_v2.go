@@ -14,8 +14,11 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/limits"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
@@ -166,7 +169,7 @@ func getInstanceNetworkInfo(ctx context.Context, d *schema.ResourceData, meta an
 		return nil, fmt.Errorf("Error creating OpenStack networking client: %w", err)
 	}
 
-	networkInfo, err := getInstanceNetworkInfoNeutron(ctx, networkClient, queryType, queryTerm)
+	networkInfo, err := getInstanceNetworkInfoNeutron(ctx, config.lookupCache, networkClient, queryType, queryTerm)
 	if err != nil {
 		return nil, fmt.Errorf("Error trying to get network information from the Network API: %w", err)
 	}
@@ -175,8 +178,8 @@ func getInstanceNetworkInfo(ctx context.Context, d *schema.ResourceData, meta an
 }
 
 // getInstanceNetworkInfoNeutron will query the neutron API for the network
-// information.
-func getInstanceNetworkInfoNeutron(ctx context.Context, client *gophercloud.ServiceClient, queryType, queryTerm string) (map[string]any, error) {
+// information. The network lookups are cached in the lookup cache.
+func getInstanceNetworkInfoNeutron(ctx context.Context, cache *lookupCache, client *gophercloud.ServiceClient, queryType, queryTerm string) (map[string]any, error) {
 	// If a port was specified, use it to look up the network ID
 	// and then query the network as if a network ID was originally used.
 	if queryType == "port" {
@@ -209,6 +212,36 @@ func getInstanceNetworkInfoNeutron(ctx context.Context, client *gophercloud.Serv
 		queryTerm = port.NetworkID
 	}
 
+	if queryType != "name" {
+		queryType = "id"
+	}
+
+	v, err := cache.lookup(client, lookupCacheNetwork, queryType, queryTerm, func() (string, string, any, error) {
+		network, err := getInstanceNetworkNeutron(ctx, client, queryType, queryTerm)
+		if err != nil {
+			return "", "", nil, err
+		}
+
+		return network.ID, network.Name, network, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	network := v.(networks.Network)
+	networkInfo := map[string]any{
+		"uuid": network.ID,
+		"name": network.Name,
+	}
+
+	log.Printf("[DEBUG] getInstanceNetworkInfoNeutron: %#v", networkInfo)
+
+	return networkInfo, nil
+}
+
+// getInstanceNetworkNeutron returns the only active network with the given
+// name or ID.
+func getInstanceNetworkNeutron(ctx context.Context, client *gophercloud.ServiceClient, queryType, queryTerm string) (networks.Network, error) {
 	listOpts := networks.ListOpts{
 		Status: "ACTIVE",
 	}
@@ -222,33 +255,22 @@ func getInstanceNetworkInfoNeutron(ctx context.Context, client *gophercloud.Serv
 
 	allPages, err := networks.List(client, listOpts).AllPages(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("Unable to retrieve networks from the Network API: %w", err)
+		return networks.Network{}, fmt.Errorf("Unable to retrieve networks from the Network API: %w", err)
 	}
 
 	allNetworks, err := networks.ExtractNetworks(allPages)
 	if err != nil {
-		return nil, fmt.Errorf("Unable to retrieve networks from the Network API: %w", err)
+		return networks.Network{}, fmt.Errorf("Unable to retrieve networks from the Network API: %w", err)
 	}
 
-	var network networks.Network
-
 	switch len(allNetworks) {
 	case 0:
-		return nil, fmt.Errorf("Could not find any matching network for %s %s", queryType, queryTerm)
+		return networks.Network{}, fmt.Errorf("Could not find any matching network for %s %s", queryType, queryTerm)
 	case 1:
-		network = allNetworks[0]
+		return allNetworks[0], nil
 	default:
-		return nil, fmt.Errorf("More than one network found for %s %s", queryType, queryTerm)
-	}
-
-	v := map[string]any{
-		"uuid": network.ID,
-		"name": network.Name,
+		return networks.Network{}, fmt.Errorf("More than one network found for %s %s", queryType, queryTerm)
 	}
-
-	log.Printf("[DEBUG] getInstanceNetworkInfoNeutron: %#v", v)
-
-	return v, nil
 }
 
 // getInstanceAddresses parses a Gophercloud server.Server's Address field into
@@ -495,3 +517,65 @@ func computeV2InstanceUpdateTags(d *schema.ResourceData) []string {
 func computeV2InstanceTags(d *schema.ResourceData) []string {
 	return expandObjectTags(d)
 }
+
+// quotaUsage formats the usage of a quota. Negative limits are unlimited.
+func quotaUsage(name string, used, limit int, unit string) string {
+	if limit < 0 {
+		return fmt.Sprintf("%s: %d%s used of unlimited", name, used, unit)
+	}
+
+	return fmt.Sprintf("%s: %d%s used of %d%s", name, used, unit, limit, unit)
+}
+
+// computeV2QuotaUsage returns a function, which returns the current compute
+// quota usage of the project from the limits API.
+func computeV2QuotaUsage(client *gophercloud.ServiceClient) func(context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		l, err := limits.Get(ctx, client, nil).Extract()
+		if err != nil {
+			return "", err
+		}
+
+		a := l.Absolute
+
+		return strings.Join([]string{
+			quotaUsage("instances", a.TotalInstancesUsed, a.MaxTotalInstances, ""),
+			quotaUsage("cores", a.TotalCoresUsed, a.MaxTotalCores, ""),
+			quotaUsage("ram", a.TotalRAMUsed, a.MaxTotalRAMSize, " MiB"),
+			quotaUsage("server_groups", a.TotalServerGroupsUsed, a.MaxServerGroups, ""),
+		}, "\n"), nil
+	}
+}
+
+// computeV2InstanceNoValidHost returns the fault of the instance, when it
+// failed to be scheduled, because no valid host was found.
+func computeV2InstanceNoValidHost(ctx context.Context, client *gophercloud.ServiceClient, instanceID string) (string, bool) {
+	server, err := servers.Get(ctx, client, instanceID).Extract()
+	if err != nil {
+		log.Printf("[DEBUG] Unable to retrieve instance %s: %s", instanceID, err)
+
+		return "", false
+	}
+
+	if server.Status != "ERROR" || !strings.Contains(strings.ToLower(server.Fault.Message), "no valid host") {
+		return "", false
+	}
+
+	return server.Fault.Message, true
+}
+
+// computeV2InstanceNoValidHostBackoff returns the delay before an instance,
+// which failed to be scheduled, is created again. The delay doubles with
+// every attempt to give the hosts time to free up resources.
+func computeV2InstanceNoValidHostBackoff(attempt int) time.Duration {
+	const (
+		minBackoff = 10 * time.Second
+		maxBackoff = 2 * time.Minute
+	)
+
+	if attempt >= 4 {
+		return maxBackoff
+	}
+
+	return min(minBackoff<<attempt, maxBackoff)
+}
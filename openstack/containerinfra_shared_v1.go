@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/containerinfra/v1/certificates"
@@ -157,6 +158,46 @@ func containerInfraClusterV1StateRefreshFunc(ctx context.Context, client *gopher
 	}
 }
 
+// containerInfraClusterV1UpdatedAt returns the time of the last update of a
+// cluster, which is passed to containerInfraClusterV1WaitForUpdate.
+func containerInfraClusterV1UpdatedAt(ctx context.Context, client *gophercloud.ServiceClient, clusterID string) (time.Time, error) {
+	c, err := clusters.Get(ctx, client, clusterID).Extract()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return c.UpdatedAt, nil
+}
+
+// containerInfraClusterV1WaitForUpdate waits for an asynchronous update of a
+// cluster, such as an upgrade or a CA rotation, to complete. Right after the
+// request Magnum may still report the status of the previous operation, so
+// the cluster is reported as UPDATE_PENDING until it has been updated after
+// updatedAt.
+func containerInfraClusterV1WaitForUpdate(ctx context.Context, client *gophercloud.ServiceClient, clusterID string, updatedAt time.Time, timeout time.Duration) error {
+	refresh := updatedAfterStateRefreshFunc(containerInfraClusterV1StateRefreshFunc(ctx, client, clusterID), func(v any) (time.Time, bool) {
+		c, ok := v.(*clusters.Cluster)
+		if !ok || c == nil {
+			return time.Time{}, false
+		}
+
+		return c.UpdatedAt, true
+	}, updatedAt, "UPDATE_PENDING")
+
+	stateConf := &retry.StateChangeConf{
+		Pending:      []string{"UPDATE_PENDING", "UPDATE_IN_PROGRESS"},
+		Target:       []string{"UPDATE_COMPLETE"},
+		Refresh:      refresh,
+		Timeout:      timeout,
+		Delay:        0,
+		PollInterval: 20 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
 // ContainerInfraNodeGroupV1StateRefreshFunc returns a retry.StateRefreshFunc
 // that is used to watch a container infra NodeGroup.
 func containerInfraNodeGroupV1StateRefreshFunc(ctx context.Context, client *gophercloud.ServiceClient, clusterID string, nodeGroupID string) retry.StateRefreshFunc {
@@ -258,12 +299,22 @@ type kubernetesConfigUserData struct {
 }
 
 func flattenContainerInfraV1Kubeconfig(ctx context.Context, d *schema.ResourceData, containerInfraClient *gophercloud.ServiceClient) (map[string]any, error) {
+	if d.Get("disable_kubeconfig").(bool) {
+		return map[string]any{}, nil
+	}
+
 	clientSert, ok := d.Get("kubeconfig.client_certificate").(string)
 	if ok && clientSert != "" {
 		return d.Get("kubeconfig").(map[string]any), nil
 	}
 
-	certificateAuthority, err := certificates.Get(ctx, containerInfraClient, d.Id()).Extract()
+	return containerInfraV1Kubeconfig(ctx, containerInfraClient, d.Id(), d.Get("name").(string), d.Get("api_address").(string))
+}
+
+// containerInfraV1Kubeconfig signs a new client certificate against the CA
+// of the cluster and renders a kubeconfig using it.
+func containerInfraV1Kubeconfig(ctx context.Context, containerInfraClient *gophercloud.ServiceClient, clusterID, name, host string) (map[string]any, error) {
+	certificateAuthority, err := certificates.Get(ctx, containerInfraClient, clusterID).Extract()
 	if err != nil {
 		return nil, fmt.Errorf("Error getting certificate authority: %w", err)
 	}
@@ -303,7 +354,7 @@ func flattenContainerInfraV1Kubeconfig(ctx context.Context, d *schema.ResourceDa
 	)
 
 	certificateCreateOpts := certificates.CreateOpts{
-		ClusterUUID: d.Id(),
+		ClusterUUID: clusterID,
 		CSR:         string(pemClientCsr),
 	}
 
@@ -312,9 +363,6 @@ func flattenContainerInfraV1Kubeconfig(ctx context.Context, d *schema.ResourceDa
 		return nil, fmt.Errorf("Error requesting client certificate: %w", err)
 	}
 
-	name := d.Get("name").(string)
-	host := d.Get("api_address").(string)
-
 	rawKubeconfig, err := renderKubeconfig(name, host, []byte(certificateAuthority.PEM), []byte(clientCertificate.PEM), pemClientKey)
 	if err != nil {
 		return nil, fmt.Errorf("Error rendering kubeconfig: %w", err)
@@ -1,11 +1,20 @@
 package openstack
 
 import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
 	"testing"
 
+	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/containerinfra/v1/clustertemplates"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/terraform-provider-openstack/utils/v2/auth"
 )
 
 func TestUnitExpandContainerInfraV1LabelsMap(t *testing.T) {
@@ -66,3 +75,98 @@ func TestUnitContainerInfraClusterTemplateV1AppendUpdateOpts(t *testing.T) {
 
 	assert.Equal(t, expectedUpdateOpts, actualUpdateOpts)
 }
+
+func TestUnitContainerInfraClusterV1Update(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	var (
+		actions       atomic.Int32
+		upgradeBodies []string
+		rotations     atomic.Int32
+	)
+
+	fakeServer.Mux.HandleFunc("/clusters/cluster", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"uuid": "cluster", "cluster_template_id": "template_1", "status": "UPDATE_COMPLETE", "updated_at": "2024-01-01T00:00:%02d+00:00"}`, actions.Load())
+	})
+
+	fakeServer.Mux.HandleFunc("/clusters/cluster/actions/upgrade", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		upgradeBodies = append(upgradeBodies, string(body))
+		actions.Add(1)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"uuid": "cluster"}`)
+	})
+
+	fakeServer.Mux.HandleFunc("/certificates/cluster", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+
+		rotations.Add(1)
+		actions.Add(1)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{}`)
+	})
+
+	config := &Config{
+		Config: auth.Config{
+			OsClient: &gophercloud.ProviderClient{
+				EndpointLocator: func(gophercloud.EndpointOpts) (string, error) {
+					return fakeServer.Endpoint(), nil
+				},
+			},
+		},
+	}
+
+	r := resourceContainerInfraClusterV1()
+	state := &terraform.InstanceState{
+		ID: "cluster",
+		Attributes: map[string]string{
+			"id":                  "cluster",
+			"cluster_template_id": "template_1",
+			"nodegroup":           "workers",
+			"disable_kubeconfig":  "true",
+		},
+	}
+
+	// A change of the cluster template upgrades the whole cluster, while
+	// the nodegroup of an earlier upgrade is kept, and a change of the
+	// trigger rotates the CA.
+	d, err := schema.InternalMap(r.Schema).Data(state, &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"cluster_template_id": {Old: "template_1", New: "template_2"},
+			"ca_rotation_trigger": {Old: "", New: "1"},
+		},
+	})
+	require.NoError(t, err)
+
+	diags := resourceContainerInfraClusterV1Update(t.Context(), d, config)
+	require.Empty(t, diags)
+	require.Len(t, upgradeBodies, 1)
+	assert.JSONEq(t, `{"cluster_template": "template_2", "max_batch_size": 1}`, upgradeBodies[0])
+	assert.Equal(t, int32(1), rotations.Load())
+
+	// A change of the nodegroup upgrades only this node group.
+	d, err = schema.InternalMap(r.Schema).Data(state, &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"nodegroup": {Old: "workers", New: "workers_2"},
+		},
+	})
+	require.NoError(t, err)
+
+	diags = resourceContainerInfraClusterV1Update(t.Context(), d, config)
+	require.Empty(t, diags)
+	require.Len(t, upgradeBodies, 2)
+	assert.JSONEq(t, `{"cluster_template": "template_1", "max_batch_size": 1, "nodegroup": "workers_2"}`, upgradeBodies[1])
+	assert.Equal(t, int32(1), rotations.Load())
+}
@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"github.com/gophercloud/gophercloud/v2"
-	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/flavors"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/tags"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -210,7 +209,7 @@ func dataSourceComputeInstanceV2Read(ctx context.Context, d *schema.ResourceData
 
 	d.Set("key_pair", server.KeyName)
 
-	flavor, err := flavors.Get(ctx, computeClient, flavorID).Extract()
+	flavorName, err := computeFlavorV2Name(ctx, config.lookupCache, computeClient, flavorID)
 	if err != nil {
 		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
 			// Original flavor was deleted, but it is possible that instance started
@@ -221,11 +220,11 @@ func dataSourceComputeInstanceV2Read(ctx context.Context, d *schema.ResourceData
 			return diag.FromErr(err)
 		}
 	} else {
-		d.Set("flavor_name", flavor.Name)
+		d.Set("flavor_name", flavorName)
 	}
 
 	// Set the instance's image information appropriately
-	if err := setImageInformation(ctx, imageClient, server, d); err != nil {
+	if err := setImageInformation(ctx, config.lookupCache, imageClient, server, d); err != nil {
 		return diag.FromErr(err)
 	}
 
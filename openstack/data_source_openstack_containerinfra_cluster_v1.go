@@ -147,6 +147,11 @@ func dataSourceContainerInfraCluster() *schema.Resource {
 				Computed: true,
 			},
 
+			"disable_kubeconfig": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"kubeconfig": {
 				Type:      schema.TypeMap,
 				Computed:  true,
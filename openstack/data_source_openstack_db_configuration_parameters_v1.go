@@ -0,0 +1,108 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDatabaseConfigurationParametersV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDatabaseConfigurationParametersV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"datastore_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"datastore_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"parameters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"min": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"max": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"restart_required": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDatabaseConfigurationParametersV1Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	databaseV1Client, err := config.DatabaseV1Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	datastoreType := d.Get("datastore_type").(string)
+	datastoreVersion := d.Get("datastore_version").(string)
+
+	params, err := databaseConfigurationV1Parameters(ctx, databaseV1Client, datastoreType, datastoreVersion)
+	if err != nil {
+		return diag.Errorf("Error retrieving openstack_db_configuration_parameters_v1 for %s %s: %s", datastoreType, datastoreVersion, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_db_configuration_parameters_v1 for %s %s: %#v", datastoreType, datastoreVersion, params)
+
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	parameters := make([]map[string]any, 0, len(names))
+	for _, name := range names {
+		p := params[name]
+		parameters = append(parameters, map[string]any{
+			"name":             p.Name,
+			"type":             p.Type,
+			"min":              p.Min,
+			"max":              p.Max,
+			"restart_required": p.RestartRequired,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", datastoreType, datastoreVersion))
+	d.Set("parameters", parameters)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatabaseV1ConfigurationParametersDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1ConfigurationParametersDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_db_configuration_parameters_v1.parameters_1", "parameters.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.openstack_db_configuration_parameters_v1.parameters_1", "parameters.*", map[string]string{
+							"name": "max_connections",
+							"type": "integer",
+						}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabaseV1ConfigurationParametersDataSourceBasic() string {
+	return fmt.Sprintf(`
+data "openstack_db_configuration_parameters_v1" "parameters_1" {
+  datastore_type    = "%s"
+  datastore_version = "%s"
+}
+`, osDBDatastoreType, osDBDatastoreVersion)
+}
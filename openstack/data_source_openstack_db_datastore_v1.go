@@ -0,0 +1,84 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/datastores"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDatabaseDatastoreV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDatabaseDatastoreV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"default_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDatabaseDatastoreV1Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	databaseV1Client, err := config.DatabaseV1Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	// Trove accepts both the name and the ID of a datastore.
+	datastore, err := datastores.Get(ctx, databaseV1Client, d.Get("name").(string)).Extract()
+	if err != nil {
+		return diag.Errorf("Error retrieving openstack_db_datastore_v1 %s: %s", d.Get("name").(string), err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_db_datastore_v1 %s: %#v", datastore.ID, datastore)
+
+	versions := make([]map[string]any, 0, len(datastore.Versions))
+	for _, v := range datastore.Versions {
+		versions = append(versions, map[string]any{
+			"id":   v.ID,
+			"name": v.Name,
+		})
+	}
+
+	d.SetId(datastore.ID)
+	d.Set("name", datastore.Name)
+	d.Set("default_version", datastore.DefaultVersion)
+	d.Set("versions", versions)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
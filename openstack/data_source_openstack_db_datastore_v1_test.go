@@ -0,0 +1,42 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatabaseV1DatastoreDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1DatastoreDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_db_datastore_v1.datastore_1", "name", osDBDatastoreType),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_db_datastore_v1.datastore_1", "default_version"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_db_datastore_v1.datastore_1", "versions.0.id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_db_datastore_v1.datastore_1", "versions.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabaseV1DatastoreDataSourceBasic() string {
+	return fmt.Sprintf(`
+data "openstack_db_datastore_v1" "datastore_1" {
+  name = "%s"
+}
+`, osDBDatastoreType)
+}
@@ -0,0 +1,112 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/flavors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDatabaseFlavorV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDatabaseFlavorV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"flavor_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"name"},
+			},
+
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"flavor_id"},
+			},
+
+			"ram": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDatabaseFlavorV1Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	databaseV1Client, err := config.DatabaseV1Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	allPages, err := flavors.List(databaseV1Client).AllPages(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to query openstack_db_flavor_v1: %s", err)
+	}
+
+	allFlavors, err := flavors.ExtractFlavors(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_db_flavor_v1: %s", err)
+	}
+
+	flavorID := d.Get("flavor_id").(string)
+	name := d.Get("name").(string)
+
+	var filteredFlavors []flavors.Flavor
+
+	for _, flavor := range allFlavors {
+		if flavorID != "" && databaseFlavorV1ID(flavor) != flavorID {
+			continue
+		}
+
+		if name != "" && flavor.Name != name {
+			continue
+		}
+
+		filteredFlavors = append(filteredFlavors, flavor)
+	}
+
+	if len(filteredFlavors) < 1 {
+		return diag.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(filteredFlavors) > 1 {
+		log.Printf("[DEBUG] Multiple results found: %#v", filteredFlavors)
+
+		return diag.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria")
+	}
+
+	flavor := filteredFlavors[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_db_flavor_v1 %s: %#v", databaseFlavorV1ID(flavor), flavor)
+
+	d.SetId(databaseFlavorV1ID(flavor))
+	d.Set("flavor_id", databaseFlavorV1ID(flavor))
+	d.Set("name", flavor.Name)
+	d.Set("ram", flavor.RAM)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+// databaseFlavorV1ID returns the ID of a flavor. Trove reports IDs, which
+// aren't integers, only as str_id.
+func databaseFlavorV1ID(flavor flavors.Flavor) string {
+	if flavor.StrID != "" {
+		return flavor.StrID
+	}
+
+	return strconv.Itoa(flavor.ID)
+}
@@ -0,0 +1,40 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatabaseV1FlavorDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1FlavorDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_db_flavor_v1.flavor_1", "flavor_id", osFlavorID),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_db_flavor_v1.flavor_1", "name"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_db_flavor_v1.flavor_1", "ram"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabaseV1FlavorDataSourceBasic() string {
+	return fmt.Sprintf(`
+data "openstack_db_flavor_v1" "flavor_1" {
+  flavor_id = "%s"
+}
+`, osFlavorID)
+}
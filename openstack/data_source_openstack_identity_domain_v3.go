@@ -0,0 +1,98 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/domains"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIdentityDomainV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIdentityDomainV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// dataSourceIdentityDomainV3Read performs the domain lookup.
+func dataSourceIdentityDomainV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	listOpts := domains.ListOpts{
+		Name: d.Get("name").(string),
+	}
+
+	if v, ok := getOkExists(d, "enabled"); ok {
+		enabled := v.(bool)
+		listOpts.Enabled = &enabled
+	}
+
+	log.Printf("[DEBUG] openstack_identity_domain_v3 list options: %#v", listOpts)
+
+	allPages, err := domains.List(identityClient, listOpts).AllPages(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to query openstack_identity_domain_v3: %s", err)
+	}
+
+	allDomains, err := domains.ExtractDomains(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_identity_domain_v3: %s", err)
+	}
+
+	if len(allDomains) < 1 {
+		return diag.Errorf("Your openstack_identity_domain_v3 query returned no results. " +
+			"Please change your search criteria and try again")
+	}
+
+	if len(allDomains) > 1 {
+		return diag.Errorf("Your openstack_identity_domain_v3 query returned more than one result")
+	}
+
+	domain := allDomains[0]
+
+	dataSourceIdentityDomainV3Attributes(d, config, &domain)
+
+	return nil
+}
+
+// dataSourceIdentityDomainV3Attributes populates the fields of a Domain resource.
+func dataSourceIdentityDomainV3Attributes(d *schema.ResourceData, config *Config, domain *domains.Domain) {
+	log.Printf("[DEBUG] openstack_identity_domain_v3 details: %#v", domain)
+
+	d.SetId(domain.ID)
+	d.Set("name", domain.Name)
+	d.Set("description", domain.Description)
+	d.Set("enabled", domain.Enabled)
+	d.Set("region", GetRegion(d, config))
+}
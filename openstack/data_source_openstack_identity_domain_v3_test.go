@@ -0,0 +1,50 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOpenStackIdentityV3DomainDataSource_basic(t *testing.T) {
+	domainName := "ACCPTTEST-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3DomainBasic(domainName),
+			},
+			{
+				Config: testAccOpenStackIdentityV3DomainDataSourceBasic(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_identity_domain_v3.domain_1", "id",
+						"openstack_identity_domain_v3.domain_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_domain_v3.domain_1", "name", domainName),
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_domain_v3.domain_1", "description", "A domain"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_domain_v3.domain_1", "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOpenStackIdentityV3DomainDataSourceBasic(domainName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_identity_domain_v3" "domain_1" {
+  name = openstack_identity_domain_v3.domain_1.name
+}
+`, testAccIdentityV3DomainBasic(domainName))
+}
@@ -0,0 +1,213 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/roles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-provider-openstack/utils/v2/hashcode"
+)
+
+func dataSourceIdentityRoleAssignmentsV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIdentityRoleAssignmentsV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"user_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"project_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"domain_id"},
+			},
+
+			"domain_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"project_id"},
+			},
+
+			"role_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"effective": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"include_names": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"include_subtree": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{"project_id"},
+			},
+
+			"inherited": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			// Computed values
+			"role_assignments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_domain_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"group_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"group_domain_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_domain_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"system": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"inherited": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dataSourceIdentityRoleAssignmentsV3Read performs the role assignments lookup.
+func dataSourceIdentityRoleAssignmentsV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	listOpts := identityRoleAssignmentsV3ListOpts{
+		ListAssignmentsOpts: roles.ListAssignmentsOpts{
+			UserID:         d.Get("user_id").(string),
+			GroupID:        d.Get("group_id").(string),
+			ScopeProjectID: d.Get("project_id").(string),
+			ScopeDomainID:  d.Get("domain_id").(string),
+			RoleID:         d.Get("role_id").(string),
+		},
+	}
+
+	if d.Get("effective").(bool) {
+		effective := true
+		listOpts.Effective = &effective
+	}
+
+	if d.Get("include_names").(bool) {
+		includeNames := true
+		listOpts.IncludeNames = &includeNames
+	}
+
+	if d.Get("include_subtree").(bool) {
+		includeSubtree := true
+		listOpts.IncludeSubtree = &includeSubtree
+	}
+
+	inherited, inheritedOk := getOkExists(d, "inherited")
+	if inheritedOk && inherited.(bool) {
+		listOpts.InheritedTo = "projects"
+	}
+
+	log.Printf("[DEBUG] openstack_identity_role_assignments_v3 list options: %#v", listOpts)
+
+	allPages, err := roles.ListAssignments(identityClient, listOpts).AllPages(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to query openstack_identity_role_assignments_v3: %s", err)
+	}
+
+	allAssignments, err := extractIdentityRoleAssignmentsV3(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_identity_role_assignments_v3: %s", err)
+	}
+
+	if inheritedOk {
+		allAssignments = filterIdentityRoleAssignmentsV3Inherited(allAssignments, inherited.(bool))
+	}
+
+	log.Printf("[DEBUG] Retrieved %d role assignments in openstack_identity_role_assignments_v3", len(allAssignments))
+
+	query, err := listOpts.ToRolesListAssignmentsQuery()
+	if err != nil {
+		return diag.Errorf("Unable to build openstack_identity_role_assignments_v3 query: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(query)))
+	d.Set("role_assignments", flattenIdentityRoleAssignmentsV3(allAssignments))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOpenStackIdentityV3RoleAssignmentsDataSource_basic(t *testing.T) {
+	name := "ACCPTTEST-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackIdentityRoleAssignmentsV3DataSourceBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_role_assignments_v3.project", "role_assignments.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_role_assignments_v3.user", "role_assignments.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_identity_role_assignments_v3.user", "role_assignments.0.role_id",
+						"openstack_identity_role_v3.role_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_role_assignments_v3.user", "role_assignments.0.role_name", name),
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_role_assignments_v3.user", "role_assignments.0.user_name", name),
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_role_assignments_v3.user", "role_assignments.0.inherited", "false"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_role_assignments_v3.effective", "role_assignments.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOpenStackIdentityRoleAssignmentsV3DataSourceBasic(name string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_project_v3" "project_1" {
+  name = "%[1]s"
+}
+
+resource "openstack_identity_user_v3" "user_1" {
+  name = "%[1]s"
+}
+
+resource "openstack_identity_group_v3" "group_1" {
+  name = "%[1]s"
+}
+
+resource "openstack_identity_user_membership_v3" "membership_1" {
+  user_id  = openstack_identity_user_v3.user_1.id
+  group_id = openstack_identity_group_v3.group_1.id
+}
+
+resource "openstack_identity_role_v3" "role_1" {
+  name = "%[1]s"
+}
+
+resource "openstack_identity_role_v3" "role_2" {
+  name = "%[1]s-2"
+}
+
+resource "openstack_identity_role_assignment_v3" "user" {
+  user_id    = openstack_identity_user_v3.user_1.id
+  project_id = openstack_identity_project_v3.project_1.id
+  role_id    = openstack_identity_role_v3.role_1.id
+}
+
+resource "openstack_identity_role_assignment_v3" "group" {
+  group_id   = openstack_identity_group_v3.group_1.id
+  project_id = openstack_identity_project_v3.project_1.id
+  role_id    = openstack_identity_role_v3.role_2.id
+}
+
+data "openstack_identity_role_assignments_v3" "project" {
+  project_id = openstack_identity_project_v3.project_1.id
+
+  depends_on = [
+    openstack_identity_role_assignment_v3.user,
+    openstack_identity_role_assignment_v3.group,
+  ]
+}
+
+data "openstack_identity_role_assignments_v3" "user" {
+  user_id       = openstack_identity_user_v3.user_1.id
+  include_names = true
+  inherited     = false
+
+  depends_on = [
+    openstack_identity_role_assignment_v3.user,
+  ]
+}
+
+data "openstack_identity_role_assignments_v3" "effective" {
+  user_id    = openstack_identity_user_v3.user_1.id
+  project_id = openstack_identity_project_v3.project_1.id
+  effective  = true
+
+  depends_on = [
+    openstack_identity_role_assignment_v3.user,
+    openstack_identity_role_assignment_v3.group,
+    openstack_identity_user_membership_v3.membership_1,
+  ]
+}
+`, name)
+}
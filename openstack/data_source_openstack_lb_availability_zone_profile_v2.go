@@ -0,0 +1,118 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLBAvailabilityZoneProfileV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBAvailabilityZoneProfileV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"availability_zone_profile_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name", "provider_name"},
+			},
+
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"availability_zone_profile_id"},
+			},
+
+			"provider_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"availability_zone_profile_id"},
+			},
+
+			"availability_zone_data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLBAvailabilityZoneProfileV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancer client: %s", err)
+	}
+
+	if id := d.Get("availability_zone_profile_id").(string); id != "" {
+		profile, err := lbAvailabilityZoneProfileV2Get(ctx, lbClient, id).Extract()
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return diag.Errorf("No availability zone profile found")
+			}
+
+			return diag.Errorf("Unable to retrieve OpenStack %s loadbalancer availability zone profile: %s", id, err)
+		}
+
+		dataSourceLBAvailabilityZoneProfileV2Attributes(d, profile)
+		d.Set("region", GetRegion(d, config))
+
+		return nil
+	}
+
+	opts := lbAvailabilityZoneProfileV2ListOpts{
+		Name:         d.Get("name").(string),
+		ProviderName: d.Get("provider_name").(string),
+	}
+
+	allPages, err := lbAvailabilityZoneProfileV2List(lbClient, opts).AllPages(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to query OpenStack loadbalancer availability zone profiles: %s", err)
+	}
+
+	allProfiles, err := extractLBAvailabilityZoneProfilesV2(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve OpenStack loadbalancer availability zone profiles: %s", err)
+	}
+
+	if len(allProfiles) < 1 {
+		return diag.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allProfiles) > 1 {
+		log.Printf("[DEBUG] Multiple results found: %#v", allProfiles)
+
+		return diag.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria")
+	}
+
+	dataSourceLBAvailabilityZoneProfileV2Attributes(d, &allProfiles[0])
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func dataSourceLBAvailabilityZoneProfileV2Attributes(d *schema.ResourceData, profile *lbAvailabilityZoneProfileV2) {
+	log.Printf("[DEBUG] Retrieved openstack_lb_availability_zone_profile_v2 %s: %#v", profile.ID, profile)
+
+	d.SetId(profile.ID)
+	d.Set("availability_zone_profile_id", profile.ID)
+	d.Set("name", profile.Name)
+	d.Set("provider_name", profile.ProviderName)
+	d.Set("availability_zone_data", profile.AvailabilityZoneData)
+}
@@ -0,0 +1,76 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLBAvailabilityZoneV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBAvailabilityZoneV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"availability_zone_profile_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLBAvailabilityZoneV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancer client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+
+	availabilityZone, err := lbAvailabilityZoneV2Get(ctx, lbClient, name).Extract()
+	if err != nil {
+		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return diag.Errorf("No availability zone found")
+		}
+
+		return diag.Errorf("Unable to retrieve OpenStack %s loadbalancer availability zone: %s", name, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_availability_zone_v2 %s: %#v", availabilityZone.Name, availabilityZone)
+
+	d.SetId(availabilityZone.Name)
+	d.Set("name", availabilityZone.Name)
+	d.Set("description", availabilityZone.Description)
+	d.Set("enabled", availabilityZone.Enabled)
+	d.Set("availability_zone_profile_id", availabilityZone.AvailabilityZoneProfileID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLBV2AvailabilityZoneDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2AvailabilityZoneDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_availability_zone_v2.az_1", "id", "test-ds"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_availability_zone_v2.az_1", "description", "test"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_availability_zone_v2.az_1", "enabled", "true"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_availability_zone_v2.az_1", "availability_zone_profile_id",
+						"openstack_lb_availability_zone_profile_v2.azp_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccLBV2AvailabilityZoneDataSourceBasic = `
+resource "openstack_lb_availability_zone_profile_v2" "azp_1" {
+  name                   = "test-ds"
+  provider_name          = "amphora"
+  availability_zone_data = jsonencode({
+    "compute_zone": "nova",
+  })
+}
+
+resource "openstack_lb_availability_zone_v2" "az_1" {
+  name                         = "test-ds"
+  description                  = "test"
+  availability_zone_profile_id = openstack_lb_availability_zone_profile_v2.azp_1.id
+}
+
+data "openstack_lb_availability_zone_v2" "az_1" {
+  name = openstack_lb_availability_zone_v2.az_1.name
+}
+`
@@ -0,0 +1,97 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceLBV2LoadbalancerStatus_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceLbV2LoadbalancerStatusConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_loadbalancer_status_v2.status_1", "name", "loadbalancer_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_loadbalancer_status_v2.status_1", "provisioning_status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_loadbalancer_status_v2.status_1", "operating_status"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_loadbalancer_status_v2.status_1", "listeners.0.id",
+						"openstack_lb_listener_v2.listener_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_loadbalancer_status_v2.status_1", "listeners.0.pools.0.id",
+						"openstack_lb_pool_v2.pool_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_loadbalancer_status_v2.status_1", "listeners.0.pools.0.members.0.id",
+						"openstack_lb_member_v2.member_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_loadbalancer_status_v2.status_1", "listeners.0.pools.0.members.0.address", "192.168.199.110"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_loadbalancer_status_v2.status_1", "listeners.0.pools.0.members.0.operating_status"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceLbV2LoadbalancerStatusConfigBasic = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  loadbalancer_provider = "octavia"
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name            = "listener_1"
+  protocol        = "HTTP"
+  protocol_port   = 80
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  name        = "pool_1"
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = openstack_lb_listener_v2.listener_1.id
+}
+
+resource "openstack_lb_member_v2" "member_1" {
+  address       = "192.168.199.110"
+  protocol_port = 8080
+  pool_id       = openstack_lb_pool_v2.pool_1.id
+  subnet_id     = openstack_networking_subnet_v2.subnet_1.id
+}
+
+data "openstack_lb_loadbalancer_status_v2" "status_1" {
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+
+  depends_on = [openstack_lb_member_v2.member_1]
+}
+`
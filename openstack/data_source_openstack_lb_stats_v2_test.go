@@ -0,0 +1,82 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceLBV2Stats_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceLbV2StatsConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_stats_v2.lb_stats", "id",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_stats_v2.lb_stats", "bytes_in"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_stats_v2.lb_stats", "total_connections"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_stats_v2.listener_stats", "id",
+						"openstack_lb_listener_v2.listener_1", "id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_stats_v2.listener_stats", "active_connections"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_stats_v2.listener_stats", "request_errors"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceLbV2StatsConfigBasic = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  loadbalancer_provider = "octavia"
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name            = "listener_1"
+  protocol        = "HTTP"
+  protocol_port   = 80
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+data "openstack_lb_stats_v2" "lb_stats" {
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+
+  depends_on = [openstack_lb_listener_v2.listener_1]
+}
+
+data "openstack_lb_stats_v2" "listener_stats" {
+  listener_id = openstack_lb_listener_v2.listener_1.id
+}
+`
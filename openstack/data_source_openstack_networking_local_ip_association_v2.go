@@ -0,0 +1,96 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetworkingLocalIPAssociationV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkingLocalIPAssociationV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"local_ip_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"fixed_port_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"fixed_ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"host": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"local_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingLocalIPAssociationV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	localIPID := d.Get("local_ip_id").(string)
+	listOpts := networkingLocalIPAssociationV2ListOpts{
+		FixedPortID: d.Get("fixed_port_id").(string),
+		FixedIP:     d.Get("fixed_ip").(string),
+		Host:        d.Get("host").(string),
+	}
+
+	pages, err := networkingLocalIPAssociationV2List(networkingClient, localIPID, listOpts).AllPages(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to list openstack_networking_local_ip_association_v2: %s", err)
+	}
+
+	allAssociations, err := extractNetworkingLocalIPAssociationsV2(pages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_networking_local_ip_association_v2: %s", err)
+	}
+
+	if len(allAssociations) < 1 {
+		return diag.Errorf("No openstack_networking_local_ip_association_v2 found")
+	}
+
+	if len(allAssociations) > 1 {
+		return diag.Errorf("More than one openstack_networking_local_ip_association_v2 found")
+	}
+
+	association := allAssociations[0]
+	id := fmt.Sprintf("%s/%s", localIPID, association.FixedPortID)
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_local_ip_association_v2 %s: %+v", id, association)
+	d.SetId(id)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("fixed_port_id", association.FixedPortID)
+	d.Set("fixed_ip", association.FixedIP)
+	d.Set("host", association.Host)
+	d.Set("local_ip_address", association.LocalIPAddress)
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetworkingLocalIPV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkingLocalIPV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"local_port_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"local_ip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ip_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingLocalIPV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	listOpts := networkingLocalIPV2ListOpts{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		ProjectID:      d.Get("project_id").(string),
+		NetworkID:      d.Get("network_id").(string),
+		LocalPortID:    d.Get("local_port_id").(string),
+		LocalIPAddress: d.Get("local_ip_address").(string),
+		IPMode:         d.Get("ip_mode").(string),
+	}
+
+	pages, err := networkingLocalIPV2List(networkingClient, listOpts).AllPages(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to list openstack_networking_local_ip_v2: %s", err)
+	}
+
+	allLocalIPs, err := extractNetworkingLocalIPsV2(pages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_networking_local_ip_v2: %s", err)
+	}
+
+	if len(allLocalIPs) < 1 {
+		return diag.Errorf("No openstack_networking_local_ip_v2 found")
+	}
+
+	if len(allLocalIPs) > 1 {
+		return diag.Errorf("More than one openstack_networking_local_ip_v2 found")
+	}
+
+	localIP := allLocalIPs[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_local_ip_v2 %s: %+v", localIP.ID, localIP)
+	d.SetId(localIP.ID)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("name", localIP.Name)
+	d.Set("description", localIP.Description)
+	d.Set("project_id", localIP.ProjectID)
+	d.Set("network_id", localIP.NetworkID)
+	d.Set("local_port_id", localIP.LocalPortID)
+	d.Set("local_ip_address", localIP.LocalIPAddress)
+	d.Set("ip_mode", localIP.IPMode)
+
+	return nil
+}
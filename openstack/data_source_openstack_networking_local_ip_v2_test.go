@@ -0,0 +1,88 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOpenStackNetworkingLocalIPV2DataSource_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-local-ip")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLocalIP(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2LocalIPBasic(name, "local IP"),
+			},
+			{
+				Config: testAccOpenStackNetworkingLocalIPV2DataSourceBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_local_ip_v2.local_ip_1", "id",
+						"openstack_networking_local_ip_v2.local_ip_1", "id"),
+					resource.TestCheckResourceAttr("data.openstack_networking_local_ip_v2.local_ip_1", "description", "local IP"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_local_ip_v2.local_ip_1", "local_ip_address",
+						"openstack_networking_local_ip_v2.local_ip_1", "local_ip_address"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenStackNetworkingLocalIPAssociationV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLocalIP(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2LocalIPAssociationBasic(),
+			},
+			{
+				Config: testAccOpenStackNetworkingLocalIPAssociationV2DataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_local_ip_association_v2.association_1", "id",
+						"openstack_networking_local_ip_association_v2.association_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_local_ip_association_v2.association_1", "fixed_port_id",
+						"openstack_networking_port_v2.port_1", "id"),
+					resource.TestCheckResourceAttr("data.openstack_networking_local_ip_association_v2.association_1", "fixed_ip", "192.168.199.10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOpenStackNetworkingLocalIPV2DataSourceBasic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_networking_local_ip_v2" "local_ip_1" {
+  name = openstack_networking_local_ip_v2.local_ip_1.name
+}
+`, testAccNetworkingV2LocalIPBasic(name, "local IP"))
+}
+
+func testAccOpenStackNetworkingLocalIPAssociationV2DataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_networking_local_ip_association_v2" "association_1" {
+  local_ip_id = openstack_networking_local_ip_association_v2.association_1.local_ip_id
+  fixed_ip    = openstack_networking_local_ip_association_v2.association_1.fixed_ip
+}
+`, testAccNetworkingV2LocalIPAssociationBasic())
+}
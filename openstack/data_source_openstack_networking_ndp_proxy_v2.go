@@ -0,0 +1,103 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetworkingNDPProxyV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkingNDPProxyV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"router_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"port_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingNDPProxyV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	listOpts := networkingNDPProxyV2ListOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		ProjectID:   d.Get("project_id").(string),
+		RouterID:    d.Get("router_id").(string),
+		PortID:      d.Get("port_id").(string),
+		IPAddress:   d.Get("ip_address").(string),
+	}
+
+	pages, err := networkingNDPProxyV2List(networkingClient, listOpts).AllPages(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to list openstack_networking_ndp_proxy_v2: %s", err)
+	}
+
+	allNDPProxies, err := extractNetworkingNDPProxiesV2(pages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_networking_ndp_proxy_v2: %s", err)
+	}
+
+	if len(allNDPProxies) < 1 {
+		return diag.Errorf("No openstack_networking_ndp_proxy_v2 found")
+	}
+
+	if len(allNDPProxies) > 1 {
+		return diag.Errorf("More than one openstack_networking_ndp_proxy_v2 found")
+	}
+
+	ndpProxy := allNDPProxies[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_ndp_proxy_v2 %s: %+v", ndpProxy.ID, ndpProxy)
+	d.SetId(ndpProxy.ID)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("name", ndpProxy.Name)
+	d.Set("description", ndpProxy.Description)
+	d.Set("project_id", ndpProxy.ProjectID)
+	d.Set("router_id", ndpProxy.RouterID)
+	d.Set("port_id", ndpProxy.PortID)
+	d.Set("ip_address", ndpProxy.IPAddress)
+
+	return nil
+}
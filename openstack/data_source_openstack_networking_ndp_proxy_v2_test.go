@@ -0,0 +1,50 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOpenStackNetworkingNDPProxyV2DataSource_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-ndp-proxy")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckNDPProxy(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2NDPProxyBasic(name, "NDP proxy"),
+			},
+			{
+				Config: testAccOpenStackNetworkingNDPProxyV2DataSourceBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_ndp_proxy_v2.ndp_proxy_1", "id",
+						"openstack_networking_ndp_proxy_v2.ndp_proxy_1", "id"),
+					resource.TestCheckResourceAttr("data.openstack_networking_ndp_proxy_v2.ndp_proxy_1", "name", name),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_ndp_proxy_v2.ndp_proxy_1", "ip_address",
+						"openstack_networking_ndp_proxy_v2.ndp_proxy_1", "ip_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOpenStackNetworkingNDPProxyV2DataSourceBasic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_networking_ndp_proxy_v2" "ndp_proxy_1" {
+  router_id = openstack_networking_ndp_proxy_v2.ndp_proxy_1.router_id
+  port_id   = openstack_networking_ndp_proxy_v2.ndp_proxy_1.port_id
+}
+`, testAccNetworkingV2NDPProxyBasic(name, "NDP proxy"))
+}
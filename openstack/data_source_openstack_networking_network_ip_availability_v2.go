@@ -0,0 +1,160 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/networkipavailabilities"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetworkingNetworkIPAvailabilityV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkingNetworkIPAvailabilityV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"network_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"ip_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice([]int{4, 6}),
+			},
+
+			"total_ips": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"used_ips": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"free_ips": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"subnet_ip_availability": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_ips": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"used_ips": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"free_ips": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingNetworkIPAvailabilityV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	listOpts := networkipavailabilities.ListOpts{
+		NetworkID:   d.Get("network_id").(string),
+		NetworkName: d.Get("network_name").(string),
+		ProjectID:   d.Get("project_id").(string),
+	}
+
+	if v, ok := d.GetOk("ip_version"); ok {
+		listOpts.IPVersion = strconv.Itoa(v.(int))
+	}
+
+	pages, err := networkipavailabilities.List(networkingClient, listOpts).AllPages(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_networking_network_ip_availability_v2: %s", err)
+	}
+
+	allAvailabilities, err := networkipavailabilities.ExtractNetworkIPAvailabilities(pages)
+	if err != nil {
+		return diag.Errorf("Unable to extract openstack_networking_network_ip_availability_v2: %s", err)
+	}
+
+	if len(allAvailabilities) < 1 {
+		return diag.Errorf("Your query returned no openstack_networking_network_ip_availability_v2. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allAvailabilities) > 1 {
+		return diag.Errorf("Your query returned more than one openstack_networking_network_ip_availability_v2." +
+			" Please try a more specific search criteria")
+	}
+
+	availability := allAvailabilities[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_network_ip_availability_v2 %s: %+v", availability.NetworkID, availability)
+
+	d.SetId(availability.NetworkID)
+	d.Set("network_id", availability.NetworkID)
+	d.Set("network_name", availability.NetworkName)
+	d.Set("project_id", availability.ProjectID)
+	d.Set("total_ips", availability.TotalIPs)
+	d.Set("used_ips", availability.UsedIPs)
+	d.Set("free_ips", networkingNetworkIPAvailabilityV2FreeIPs(availability.TotalIPs, availability.UsedIPs))
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("subnet_ip_availability", flattenNetworkingNetworkIPAvailabilityV2Subnets(availability.SubnetIPAvailabilities)); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_networking_network_ip_availability_v2 subnet_ip_availability: %s", err)
+	}
+
+	return nil
+}
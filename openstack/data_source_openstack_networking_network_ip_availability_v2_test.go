@@ -0,0 +1,65 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkingV2NetworkIPAvailabilityDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2NetworkIPAvailabilityDataSourceNetwork,
+			},
+			{
+				Config: testAccNetworkingV2NetworkIPAvailabilityDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_network_ip_availability_v2.availability_1", "network_id",
+						"openstack_networking_network_v2.network_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_network_ip_availability_v2.availability_1", "network_name", "network_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_network_ip_availability_v2.availability_1", "total_ips", "253"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_network_ip_availability_v2.availability_1", "subnet_ip_availability.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_network_ip_availability_v2.availability_1", "subnet_ip_availability.0.subnet_id",
+						"openstack_networking_subnet_v2.subnet_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_network_ip_availability_v2.availability_1", "subnet_ip_availability.0.cidr", "192.168.199.0/24"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_network_ip_availability_v2.availability_1", "subnet_ip_availability.0.ip_version", "4"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_networking_network_ip_availability_v2.availability_1", "free_ips"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNetworkingV2NetworkIPAvailabilityDataSourceNetwork = `
+resource "openstack_networking_network_v2" "network_1" {
+  name           = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name       = "subnet_1"
+  cidr       = "192.168.199.0/24"
+  ip_version = 4
+  network_id = openstack_networking_network_v2.network_1.id
+}
+`
+
+const testAccNetworkingV2NetworkIPAvailabilityDataSourceBasic = testAccNetworkingV2NetworkIPAvailabilityDataSourceNetwork + `
+data "openstack_networking_network_ip_availability_v2" "availability_1" {
+  network_id = openstack_networking_network_v2.network_1.id
+}
+`
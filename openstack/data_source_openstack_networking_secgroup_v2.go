@@ -2,9 +2,11 @@ package openstack
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strings"
 
+	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -83,25 +85,23 @@ func dataSourceNetworkingSecGroupV2Read(ctx context.Context, d *schema.ResourceD
 		listOpts.Tags = strings.Join(tags, ",")
 	}
 
-	pages, err := groups.List(networkingClient, listOpts).AllPages(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	allSecGroups, err := groups.ExtractGroups(pages)
-	if err != nil {
-		return diag.Errorf("Unable to retrieve security groups: %s", err)
+	// A lookup only by name is shared with the other resources and data
+	// sources through the lookup cache.
+	cache := config.lookupCache
+	if listOpts.Name == "" || listOpts != (groups.ListOpts{Name: listOpts.Name}) {
+		cache = nil
 	}
 
-	if len(allSecGroups) < 1 {
-		return diag.Errorf("No Security Group found with name: %s", d.Get("name"))
-	}
+	v, err := cache.lookup(networkingClient, lookupCacheSecGroup, "name", listOpts.Name, func() (string, string, any, error) {
+		secGroup, err := dataSourceNetworkingSecGroupV2Find(ctx, networkingClient, listOpts)
 
-	if len(allSecGroups) > 1 {
-		return diag.Errorf("More than one Security Group found with name: %s", d.Get("name"))
+		return secGroup.ID, secGroup.Name, secGroup, err
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	secGroup := allSecGroups[0]
+	secGroup := v.(groups.SecGroup)
 
 	log.Printf("[DEBUG] Retrieved Security Group %s: %+v", secGroup.ID, secGroup)
 	d.SetId(secGroup.ID)
@@ -115,3 +115,25 @@ func dataSourceNetworkingSecGroupV2Read(ctx context.Context, d *schema.ResourceD
 
 	return nil
 }
+
+func dataSourceNetworkingSecGroupV2Find(ctx context.Context, networkingClient *gophercloud.ServiceClient, listOpts groups.ListOpts) (groups.SecGroup, error) {
+	pages, err := groups.List(networkingClient, listOpts).AllPages(ctx)
+	if err != nil {
+		return groups.SecGroup{}, err
+	}
+
+	allSecGroups, err := groups.ExtractGroups(pages)
+	if err != nil {
+		return groups.SecGroup{}, fmt.Errorf("Unable to retrieve security groups: %w", err)
+	}
+
+	if len(allSecGroups) < 1 {
+		return groups.SecGroup{}, fmt.Errorf("No Security Group found with name: %s", listOpts.Name)
+	}
+
+	if len(allSecGroups) > 1 {
+		return groups.SecGroup{}, fmt.Errorf("More than one Security Group found with name: %s", listOpts.Name)
+	}
+
+	return allSecGroups[0], nil
+}
@@ -3,7 +3,6 @@ package openstack
 import (
 	"context"
 	"log"
-	"strconv"
 
 	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/shares"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -93,6 +92,10 @@ func dataSourceSharedFilesystemShareV2() *schema.Resource {
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"path": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -101,10 +104,19 @@ func dataSourceSharedFilesystemShareV2() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"is_admin_only": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
 					},
 				},
 			},
 
+			"preferred_export_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"availability_zone": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -180,13 +192,7 @@ func dataSourceSharedFilesystemShareV2Read(ctx context.Context, d *schema.Resour
 
 	log.Printf("[DEBUG] Retrieved share's export_locations %s: %#v", share.ID, exportLocationsRaw)
 
-	exportLocations := make([]map[string]string, 0, len(exportLocationsRaw))
-	for _, v := range exportLocationsRaw {
-		exportLocations = append(exportLocations, map[string]string{
-			"path":      v.Path,
-			"preferred": strconv.FormatBool(v.Preferred),
-		})
-	}
+	exportLocations := flattenSharedFilesystemShareV2ExportLocations(exportLocationsRaw)
 
 	d.SetId(share.ID)
 	d.Set("name", share.Name)
@@ -209,5 +215,7 @@ func dataSourceSharedFilesystemShareV2Read(ctx context.Context, d *schema.Resour
 		log.Printf("[DEBUG] Unable to set export_locations for share %s: %s", share.ID, err)
 	}
 
+	d.Set("preferred_export_location", sharedFilesystemShareV2PreferredExportLocation(exportLocationsRaw))
+
 	return nil
 }
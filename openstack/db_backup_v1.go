@@ -0,0 +1,118 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// databaseBackupV1 represents a Trove backup. Gophercloud doesn't support
+// the backups API.
+type databaseBackupV1 struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	InstanceID  string  `json:"instance_id"`
+	ParentID    string  `json:"parent_id"`
+	ProjectID   string  `json:"project_id"`
+	Status      string  `json:"status"`
+	Size        float64 `json:"size"`
+	LocationRef string  `json:"locationRef"`
+	Created     string  `json:"created"`
+	Updated     string  `json:"updated"`
+	Datastore   struct {
+		Type      string `json:"type"`
+		Version   string `json:"version"`
+		VersionID string `json:"version_id"`
+	} `json:"datastore"`
+}
+
+// databaseBackupV1CreateOpts represents the attributes used when creating a
+// backup.
+type databaseBackupV1CreateOpts struct {
+	Name           string `json:"name" required:"true"`
+	Description    string `json:"description,omitempty"`
+	Instance       string `json:"instance" required:"true"`
+	ParentID       string `json:"parent_id,omitempty"`
+	SwiftContainer string `json:"swift_container,omitempty"`
+}
+
+type databaseBackupV1Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets the response as a backup.
+func (r databaseBackupV1Result) Extract() (*databaseBackupV1, error) {
+	var s struct {
+		Backup *databaseBackupV1 `json:"backup"`
+	}
+
+	err := r.ExtractInto(&s)
+
+	return s.Backup, err
+}
+
+func databaseBackupV1Create(ctx context.Context, client *gophercloud.ServiceClient, opts databaseBackupV1CreateOpts) (r databaseBackupV1Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "backup")
+	if err != nil {
+		r.Err = err
+
+		return
+	}
+
+	resp, err := client.Post(ctx, client.ServiceURL("backups"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func databaseBackupV1Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (r databaseBackupV1Result) {
+	resp, err := client.Get(ctx, client.ServiceURL("backups", id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func databaseBackupV1Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(ctx, client.ServiceURL("backups", id), &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func flattenDatabaseBackupV1Datastore(backup *databaseBackupV1) []map[string]any {
+	return []map[string]any{
+		{
+			"type":    backup.Datastore.Type,
+			"version": backup.Datastore.Version,
+		},
+	}
+}
+
+// databaseBackupV1StateRefreshFunc returns a retry.StateRefreshFunc that is
+// used to watch a database backup.
+func databaseBackupV1StateRefreshFunc(ctx context.Context, client *gophercloud.ServiceClient, backupID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		b, err := databaseBackupV1Get(ctx, client, backupID).Extract()
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return b, "DELETED", nil
+			}
+
+			return nil, "", err
+		}
+
+		if b.Status == "FAILED" || b.Status == "DELETE_FAILED" {
+			return b, b.Status, errors.New("There was an error processing the database backup")
+		}
+
+		return b, b.Status, nil
+	}
+}
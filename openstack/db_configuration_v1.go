@@ -2,12 +2,17 @@ package openstack
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
 	"net/http"
+	"sort"
 	"strconv"
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/configurations"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func expandDatabaseConfigurationV1Datastore(rawDatastore []any) configurations.DatastoreOpts {
@@ -60,3 +65,181 @@ func databaseConfigurationV1StateRefreshFunc(ctx context.Context, client *gopher
 		return i, "ACTIVE", nil
 	}
 }
+
+// databaseConfigurationV1Parameters returns the configuration parameters
+// supported by a datastore version, indexed by name. Trove accepts both names
+// and IDs for the datastore and its version.
+func databaseConfigurationV1Parameters(ctx context.Context, client *gophercloud.ServiceClient, datastore, version string) (map[string]configurations.Param, error) {
+	allPages, err := configurations.ListDatastoreParams(client, datastore, version).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allParams, err := configurations.ExtractParams(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	params := make(map[string]configurations.Param, len(allParams))
+	for _, p := range allParams {
+		params[p.Name] = p
+	}
+
+	return params, nil
+}
+
+// validateDatabaseConfigurationV1Values checks the configuration values
+// against the parameters supported by the datastore version and returns the
+// names of the parameters which require an instance restart.
+func validateDatabaseConfigurationV1Values(params map[string]configurations.Param, rawValues []any) ([]string, error) {
+	var (
+		errs            []error
+		restartRequired []string
+	)
+
+	for _, rawValue := range rawValues {
+		v := rawValue.(map[string]any)
+		name := v["name"].(string)
+		value := v["value"].(string)
+
+		param, ok := params[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("configuration parameter %q is not supported by the datastore version", name))
+
+			continue
+		}
+
+		if err := validateDatabaseConfigurationV1Value(param, value); err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		if param.RestartRequired {
+			restartRequired = append(restartRequired, name)
+		}
+	}
+
+	sort.Strings(restartRequired)
+
+	return restartRequired, errors.Join(errs...)
+}
+
+func validateDatabaseConfigurationV1Value(param configurations.Param, value string) error {
+	var number float64
+
+	switch param.Type {
+	case "integer":
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("configuration parameter %q must be an integer, got %q", param.Name, value)
+		}
+
+		number = float64(i)
+	case "float":
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("configuration parameter %q must be a float, got %q", param.Name, value)
+		}
+
+		number = f
+	case "boolean":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("configuration parameter %q must be a boolean, got %q", param.Name, value)
+		}
+
+		return nil
+	default:
+		return nil
+	}
+
+	// Trove omits the boundaries for unbounded parameters.
+	if param.Max > param.Min && (number < param.Min || number > param.Max) {
+		return fmt.Errorf("configuration parameter %q must be between %s and %s, got %q", param.Name,
+			strconv.FormatFloat(param.Min, 'f', -1, 64), strconv.FormatFloat(param.Max, 'f', -1, 64), value)
+	}
+
+	return nil
+}
+
+// resourceDatabaseConfigurationV1CustomizeDiff validates the configuration
+// values against the datastore version parameters at plan time and reports
+// the parameters which require an instance restart.
+func resourceDatabaseConfigurationV1CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() != "" && !d.HasChange("configuration") && !d.HasChange("datastore") {
+		return nil
+	}
+
+	if !d.NewValueKnown("configuration") || !d.NewValueKnown("datastore") || !d.NewValueKnown("region") {
+		return resourceDatabaseConfigurationV1RestartRequiredComputed(d)
+	}
+
+	rawDatastore := d.Get("datastore").([]any)
+	if len(rawDatastore) == 0 || rawDatastore[0] == nil {
+		return nil
+	}
+
+	datastore := expandDatabaseConfigurationV1Datastore(rawDatastore)
+
+	config := meta.(*Config)
+
+	region := d.Get("region").(string)
+	if region == "" {
+		region = config.Region
+	}
+
+	databaseV1Client, err := config.DatabaseV1Client(ctx, region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack database client: %w", err)
+	}
+
+	params, err := databaseConfigurationV1Parameters(ctx, databaseV1Client, datastore.Type, datastore.Version)
+	if err != nil {
+		// Not every datastore exposes its parameters, let Trove validate
+		// the values on apply.
+		log.Printf("[DEBUG] Unable to retrieve %s %s configuration parameters, skipping validation: %s", datastore.Type, datastore.Version, err)
+
+		return resourceDatabaseConfigurationV1RestartRequiredComputed(d)
+	}
+
+	restartRequired, err := validateDatabaseConfigurationV1Values(params, d.Get("configuration").([]any))
+	if err != nil {
+		return fmt.Errorf("Invalid openstack_db_configuration_v1 configuration: %w", err)
+	}
+
+	if len(restartRequired) > 0 {
+		log.Printf("[WARN] openstack_db_configuration_v1 parameters %v require a restart of the attached instances", restartRequired)
+	}
+
+	if err := d.SetNew("restart_required", len(restartRequired) > 0); err != nil {
+		return err
+	}
+
+	return d.SetNew("restart_required_parameters", restartRequired)
+}
+
+// resourceDatabaseConfigurationV1RestartRequiredComputed marks the restart
+// attributes as known after apply, when they can't be computed at plan time.
+func resourceDatabaseConfigurationV1RestartRequiredComputed(d *schema.ResourceDiff) error {
+	if err := d.SetNewComputed("restart_required"); err != nil {
+		return err
+	}
+
+	return d.SetNewComputed("restart_required_parameters")
+}
+
+// databaseConfigurationV1RestartRequired returns the names of the configured
+// parameters which require an instance restart.
+func databaseConfigurationV1RestartRequired(params map[string]configurations.Param, values map[string]any) []string {
+	var restartRequired []string
+
+	for name := range values {
+		if param, ok := params[name]; ok && param.RestartRequired {
+			restartRequired = append(restartRequired, name)
+		}
+	}
+
+	sort.Strings(restartRequired)
+
+	return restartRequired
+}
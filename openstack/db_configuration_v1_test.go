@@ -5,6 +5,7 @@ import (
 
 	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/configurations"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUnitExpandDatabaseConfigurationV1Datastore(t *testing.T) {
@@ -72,3 +73,73 @@ func TestUnitExpandDatabaseConfigurationV1Values(t *testing.T) {
 	actual := expandDatabaseConfigurationV1Values(values)
 	assert.Equal(t, expected, actual)
 }
+
+func TestUnitValidateDatabaseConfigurationV1Values(t *testing.T) {
+	params := map[string]configurations.Param{
+		"max_connections": {
+			Name: "max_connections",
+			Type: "integer",
+			Min:  1,
+			Max:  100000,
+		},
+		"innodb_buffer_pool_size": {
+			Name:            "innodb_buffer_pool_size",
+			Type:            "integer",
+			Min:             5242880,
+			Max:             68719476736,
+			RestartRequired: true,
+		},
+		"autocommit": {
+			Name: "autocommit",
+			Type: "boolean",
+		},
+		"collation_server": {
+			Name: "collation_server",
+			Type: "string",
+		},
+	}
+
+	values := []any{
+		map[string]any{"name": "max_connections", "value": "200"},
+		map[string]any{"name": "innodb_buffer_pool_size", "value": "134217728"},
+		map[string]any{"name": "autocommit", "value": "true"},
+		map[string]any{"name": "collation_server", "value": "latin1_swedish_ci"},
+	}
+
+	restartRequired, err := validateDatabaseConfigurationV1Values(params, values)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"innodb_buffer_pool_size"}, restartRequired)
+
+	values = []any{
+		map[string]any{"name": "max_connections", "value": "many"},
+		map[string]any{"name": "innodb_buffer_pool_size", "value": "1"},
+		map[string]any{"name": "autocommit", "value": "maybe"},
+		map[string]any{"name": "unknown_parameter", "value": "1"},
+	}
+
+	restartRequired, err = validateDatabaseConfigurationV1Values(params, values)
+	require.Error(t, err)
+	assert.Empty(t, restartRequired)
+	assert.Contains(t, err.Error(), `configuration parameter "max_connections" must be an integer, got "many"`)
+	assert.Contains(t, err.Error(), `configuration parameter "innodb_buffer_pool_size" must be between 5242880 and 68719476736, got "1"`)
+	assert.Contains(t, err.Error(), `configuration parameter "autocommit" must be a boolean, got "maybe"`)
+	assert.Contains(t, err.Error(), `configuration parameter "unknown_parameter" is not supported by the datastore version`)
+}
+
+func TestUnitDatabaseConfigurationV1RestartRequired(t *testing.T) {
+	params := map[string]configurations.Param{
+		"max_connections":         {Name: "max_connections"},
+		"innodb_buffer_pool_size": {Name: "innodb_buffer_pool_size", RestartRequired: true},
+		"innodb_log_file_size":    {Name: "innodb_log_file_size", RestartRequired: true},
+	}
+
+	values := map[string]any{
+		"max_connections":         200,
+		"innodb_log_file_size":    50331648,
+		"innodb_buffer_pool_size": 134217728,
+		"unknown_parameter":       1,
+	}
+
+	assert.Equal(t, []string{"innodb_buffer_pool_size", "innodb_log_file_size"}, databaseConfigurationV1RestartRequired(params, values))
+	assert.Empty(t, databaseConfigurationV1RestartRequired(params, map[string]any{"max_connections": 200}))
+}
@@ -3,10 +3,13 @@ package openstack
 import (
 	"context"
 	"errors"
+	"log"
 	"net/http"
+	"time"
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/databases"
+	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/datastores"
 	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/instances"
 	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/users"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -102,3 +105,150 @@ func expandInstanceV1UserDatabases(v []any) databases.BatchCreateOpts {
 
 	return dbs
 }
+
+// databaseInstanceV1CreateOpts adds the replication and restore attributes
+// to the gophercloud create options.
+type databaseInstanceV1CreateOpts struct {
+	instances.CreateOpts
+
+	// ReplicaOf is the ID of the instance to replicate from.
+	ReplicaOf string
+
+	// BackupID is the ID of a backup to restore the instance from.
+	BackupID string
+}
+
+// ToInstanceCreateMap will render a JSON map.
+func (opts databaseInstanceV1CreateOpts) ToInstanceCreateMap() (map[string]any, error) {
+	b, err := opts.CreateOpts.ToInstanceCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	instance := b["instance"].(map[string]any)
+
+	if opts.ReplicaOf != "" {
+		instance["replica_of"] = opts.ReplicaOf
+	}
+
+	if opts.BackupID != "" {
+		instance["restorePoint"] = map[string]any{
+			"backupRef": opts.BackupID,
+		}
+	}
+
+	return b, nil
+}
+
+// extractDatabaseInstanceV1ReplicaOf returns the ID of the replication source
+// of an instance, which isn't exposed by gophercloud.
+func extractDatabaseInstanceV1ReplicaOf(r instances.GetResult) (string, error) {
+	var s struct {
+		Instance struct {
+			ReplicaOf *struct {
+				ID string `json:"id"`
+			} `json:"replica_of"`
+		} `json:"instance"`
+	}
+
+	err := r.ExtractInto(&s)
+	if err != nil || s.Instance.ReplicaOf == nil {
+		return "", err
+	}
+
+	return s.Instance.ReplicaOf.ID, nil
+}
+
+func flattenDatabaseInstanceV1Datastore(instance *instances.Instance, version string) []map[string]any {
+	return []map[string]any{
+		{
+			"type":    instance.Datastore.Type,
+			"version": version,
+		},
+	}
+}
+
+// databaseInstanceV1DatastoreVersion returns the datastore version to store
+// for an instance. Trove reports the name of the version, so a configured
+// version ID is kept, as long as it refers to the same version.
+func databaseInstanceV1DatastoreVersion(ctx context.Context, client *gophercloud.ServiceClient, instance *instances.Instance, configured string) string {
+	if configured == "" || configured == instance.Datastore.Version {
+		return instance.Datastore.Version
+	}
+
+	version, err := datastores.GetVersion(ctx, client, instance.Datastore.Type, configured).Extract()
+	if err != nil {
+		log.Printf("[DEBUG] Unable to retrieve datastore version %s of openstack_db_instance_v1 %s: %s", configured, instance.ID, err)
+
+		return instance.Datastore.Version
+	}
+
+	if version.ID == configured && version.Name == instance.Datastore.Version {
+		return configured
+	}
+
+	return instance.Datastore.Version
+}
+
+// databaseInstanceV1PromoteToReplicaSource promotes a replica to be the new
+// replication source.
+func databaseInstanceV1PromoteToReplicaSource(ctx context.Context, client *gophercloud.ServiceClient, id string) (r instances.ActionResult) {
+	b := map[string]any{"promote_to_replica_source": struct{}{}}
+	resp, err := client.Post(ctx, client.ServiceURL("instances", id, "action"), &b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+// databaseInstanceV1UpgradeDatastore upgrades the datastore version of an
+// instance.
+func databaseInstanceV1UpgradeDatastore(ctx context.Context, client *gophercloud.ServiceClient, id, version string) (r instances.ActionResult) {
+	b := map[string]any{"instance": map[string]any{"datastore_version": version}}
+	resp, err := client.Patch(ctx, client.ServiceURL("instances", id), &b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+// databaseInstanceV1UpdatedAt returns the time of the last update of an
+// instance, which is passed to databaseInstanceV1WaitForActive.
+func databaseInstanceV1UpdatedAt(ctx context.Context, client *gophercloud.ServiceClient, id string) (time.Time, error) {
+	instance, err := instances.Get(ctx, client, id).Extract()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return instance.Updated, nil
+}
+
+// databaseInstanceV1WaitForActive waits for an instance to finish an
+// asynchronous action like a resize, an upgrade or a promotion. Trove may
+// still report the instance as ACTIVE right after the action was accepted, so
+// it is reported as PENDING until it has been updated after updatedAt.
+func databaseInstanceV1WaitForActive(ctx context.Context, client *gophercloud.ServiceClient, id string, updatedAt time.Time, timeout time.Duration) error {
+	refresh := updatedAfterStateRefreshFunc(databaseInstanceV1StateRefreshFunc(ctx, client, id), func(v any) (time.Time, bool) {
+		instance, ok := v.(*instances.Instance)
+		if !ok || instance == nil {
+			return time.Time{}, false
+		}
+
+		return instance.Updated, true
+	}, updatedAt, "PENDING")
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"PENDING", "BUILD", "RESIZE", "UPGRADE", "PROMOTE", "EJECT", "BACKUP", "REBOOT"},
+		Target:     []string{"ACTIVE", "HEALTHY"},
+		Refresh:    refresh,
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
@@ -1,13 +1,22 @@
 package openstack
 
 import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/databases"
+	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/datastores"
 	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/instances"
 	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/users"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	thclient "github.com/gophercloud/gophercloud/v2/testhelper/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUnitExpandDatabaseInstanceV1Datastore(t *testing.T) {
@@ -104,3 +113,127 @@ func TestUnitExpandDatabaseInstanceV1Users(t *testing.T) {
 	actual := expandDatabaseInstanceV1Users(userList)
 	assert.Equal(t, expected, actual)
 }
+
+func TestUnitDatabaseInstanceV1CreateOpts(t *testing.T) {
+	createOpts := databaseInstanceV1CreateOpts{
+		CreateOpts: instances.CreateOpts{
+			FlavorRef: "1",
+			Name:      "replica",
+			Size:      10,
+		},
+		ReplicaOf: "3b8e3f5a-bd31-4a4f-8d8e-6e1cd8c2b7a0",
+		BackupID:  "9a2f6c1d-1c55-4b5e-a7c3-0f0d7e2b9c11",
+	}
+
+	expected := map[string]any{
+		"instance": map[string]any{
+			"flavorRef":  "1",
+			"name":       "replica",
+			"replica_of": "3b8e3f5a-bd31-4a4f-8d8e-6e1cd8c2b7a0",
+			"restorePoint": map[string]any{
+				"backupRef": "9a2f6c1d-1c55-4b5e-a7c3-0f0d7e2b9c11",
+			},
+			"volume": map[string]any{
+				"size": 10,
+			},
+		},
+	}
+
+	actual, err := createOpts.ToInstanceCreateMap()
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitExtractDatabaseInstanceV1ReplicaOf(t *testing.T) {
+	var r instances.GetResult
+
+	r.Body = map[string]any{
+		"instance": map[string]any{
+			"id": "9a2f6c1d-1c55-4b5e-a7c3-0f0d7e2b9c11",
+			"replica_of": map[string]any{
+				"id": "3b8e3f5a-bd31-4a4f-8d8e-6e1cd8c2b7a0",
+			},
+		},
+	}
+
+	actual, err := extractDatabaseInstanceV1ReplicaOf(r)
+	require.NoError(t, err)
+	assert.Equal(t, "3b8e3f5a-bd31-4a4f-8d8e-6e1cd8c2b7a0", actual)
+
+	r.Body = map[string]any{
+		"instance": map[string]any{
+			"id": "3b8e3f5a-bd31-4a4f-8d8e-6e1cd8c2b7a0",
+		},
+	}
+
+	actual, err = extractDatabaseInstanceV1ReplicaOf(r)
+	require.NoError(t, err)
+	assert.Empty(t, actual)
+}
+
+func TestUnitDatabaseInstanceV1DatastoreVersion(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/datastores/mysql/versions/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch strings.TrimPrefix(r.URL.Path, "/datastores/mysql/versions/") {
+		case "version_1", "5.7":
+			fmt.Fprint(w, `{"version": {"id": "version_1", "name": "5.7"}}`)
+		case "version_2":
+			fmt.Fprint(w, `{"version": {"id": "version_2", "name": "8.0"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := thclient.ServiceClient(fakeServer)
+
+	instance := &instances.Instance{
+		ID:        "instance",
+		Datastore: datastores.DatastorePartial{Type: "mysql", Version: "5.7"},
+	}
+
+	testCases := []struct {
+		configured string
+		expected   string
+	}{
+		{configured: "", expected: "5.7"},
+		{configured: "5.7", expected: "5.7"},
+		// The ID of the version of the instance is kept.
+		{configured: "version_1", expected: "version_1"},
+		// Other versions are reported by name, so that they are upgraded.
+		{configured: "version_2", expected: "5.7"},
+		{configured: "unknown", expected: "5.7"},
+	}
+
+	for _, tc := range testCases {
+		actual := databaseInstanceV1DatastoreVersion(t.Context(), client, instance, tc.configured)
+		assert.Equal(t, tc.expected, actual, tc.configured)
+	}
+}
+
+func TestUnitDatabaseInstanceV1WaitForActive(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	var gets atomic.Int32
+
+	fakeServer.Mux.HandleFunc("/instances/instance", func(w http.ResponseWriter, _ *http.Request) {
+		// The instance is still ACTIVE right after the action was accepted.
+		updated := "2024-01-01T00:00:00"
+		if gets.Add(1) > 1 {
+			updated = "2024-01-01T00:10:00"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"instance": {"id": "instance", "status": "ACTIVE", "created": "2024-01-01T00:00:00", "updated": "%s"}}`, updated)
+	})
+
+	client := thclient.ServiceClient(fakeServer)
+	updatedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, databaseInstanceV1WaitForActive(t.Context(), client, "instance", updatedAt, time.Minute))
+	assert.Equal(t, int32(2), gets.Load())
+}
@@ -0,0 +1,132 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/containerinfra/v1/clusters"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &containerInfraKubeconfigV1EphemeralResource{}
+
+type containerInfraKubeconfigV1EphemeralResource struct {
+	config *Config
+}
+
+type containerInfraKubeconfigV1EphemeralResourceModel struct {
+	Region               types.String `tfsdk:"region"`
+	ClusterID            types.String `tfsdk:"cluster_id"`
+	RawConfig            types.String `tfsdk:"raw_config"`
+	Host                 types.String `tfsdk:"host"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+}
+
+func (r *containerInfraKubeconfigV1EphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_containerinfra_kubeconfig_v1"
+}
+
+func (r *containerInfraKubeconfigV1EphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+
+			"cluster_id": schema.StringAttribute{
+				Required: true,
+			},
+
+			"raw_config": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"host": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"cluster_ca_certificate": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"client_certificate": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"client_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (r *containerInfraKubeconfigV1EphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, _ *ephemeral.ConfigureResponse) {
+	if config, ok := req.ProviderData.(*Config); ok {
+		r.config = config
+	}
+}
+
+func (r *containerInfraKubeconfigV1EphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.config == nil {
+		resp.Diagnostics.AddError("Unconfigured OpenStack provider",
+			"The OpenStack provider must be configured before opening openstack_containerinfra_kubeconfig_v1.")
+
+		return
+	}
+
+	var data containerInfraKubeconfigV1EphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region := data.Region.ValueString()
+	if region == "" {
+		region = r.config.Region
+	}
+
+	containerInfraClient, err := r.config.ContainerInfraV1Client(ctx, region)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating OpenStack container infra client", err.Error())
+
+		return
+	}
+
+	clusterID := data.ClusterID.ValueString()
+
+	cluster, err := clusters.Get(ctx, containerInfraClient, clusterID).Extract()
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error retrieving openstack_containerinfra_cluster_v1 %s", clusterID), err.Error())
+
+		return
+	}
+
+	log.Printf("[DEBUG] Signing a client certificate for openstack_containerinfra_cluster_v1 %s", cluster.UUID)
+
+	kubeconfig, err := containerInfraV1Kubeconfig(ctx, containerInfraClient, cluster.UUID, cluster.Name, cluster.APIAddress)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error building kubeconfig for openstack_containerinfra_cluster_v1 %s", cluster.UUID), err.Error())
+
+		return
+	}
+
+	data.Region = types.StringValue(region)
+	data.RawConfig = types.StringValue(kubeconfig["raw_config"].(string))
+	data.Host = types.StringValue(kubeconfig["host"].(string))
+	data.ClusterCACertificate = types.StringValue(kubeconfig["cluster_ca_certificate"].(string))
+	data.ClientCertificate = types.StringValue(kubeconfig["client_certificate"].(string))
+	data.ClientKey = types.StringValue(kubeconfig["client_key"].(string))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
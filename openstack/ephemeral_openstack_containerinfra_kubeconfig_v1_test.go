@@ -0,0 +1,115 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/containerinfra/v1/clusters"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccContainerInfraV1Kubeconfig_basic(t *testing.T) {
+	var cluster clusters.Cluster
+
+	resourceName := "openstack_containerinfra_cluster_v1.cluster_1"
+	clusterName := acctest.RandomWithPrefix("tf-acc-cluster")
+	keypairName := acctest.RandomWithPrefix("tf-acc-keypair")
+	clusterTemplateName := acctest.RandomWithPrefix("tf-acc-clustertemplate")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			t.Skip("Currently failing in GH-A: cant deploy cluster")
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckContainerInfra(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"openstack": func() (tfprotov5.ProviderServer, error) {
+				muxServer, err := tf5muxserver.NewMuxServer(t.Context(), ProviderServers()...)
+				if err != nil {
+					return nil, err
+				}
+
+				return muxServer.ProviderServer(), nil
+			},
+		},
+		CheckDestroy: testAccCheckContainerInfraV1ClusterDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerInfraV1KubeconfigBasic(keypairName, clusterTemplateName, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerInfraV1ClusterExists(t.Context(), resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "disable_kubeconfig", "true"),
+					resource.TestCheckNoResourceAttr(resourceName, "kubeconfig.raw_config"),
+					resource.TestCheckNoResourceAttr(resourceName, "kubeconfig.client_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccContainerInfraV1KubeconfigBasic(keypairName, clusterTemplateName, clusterName string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_router_v2" "router_1" {
+  name                = "my_router"
+  external_network_id = "%s"
+}
+
+resource "openstack_networking_router_interface_v2" "router_interface_1" {
+  router_id = openstack_networking_router_v2.router_1.id
+  subnet_id = openstack_networking_subnet_v2.cluster_subnet_1.id
+}
+
+resource "openstack_networking_network_v2" "cluster_network_1" {
+  name           = "cluster-network"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "cluster_subnet_1" {
+  name       = "cluster-network-subnet"
+  network_id = openstack_networking_network_v2.cluster_network_1.id
+  cidr       = "192.168.199.0/24"
+}
+
+resource "openstack_compute_keypair_v2" "keypair_1" {
+  name = "%s"
+}
+
+resource "openstack_containerinfra_clustertemplate_v1" "clustertemplate_1" {
+  name                  = "%s"
+  image                 = "%s"
+  coe                   = "kubernetes"
+  floating_ip_enabled   = false
+  volume_driver         = "cinder"
+  docker_storage_driver = "overlay2"
+  docker_volume_size    = 5
+  fixed_network         = openstack_networking_network_v2.cluster_network_1.name
+  fixed_subnet          = openstack_networking_subnet_v2.cluster_subnet_1.name
+  external_network_id   = "%s"
+  network_driver        = "flannel"
+}
+
+resource "openstack_containerinfra_cluster_v1" "cluster_1" {
+  name                = "%s"
+  cluster_template_id = openstack_containerinfra_clustertemplate_v1.clustertemplate_1.id
+  flavor              = "%s"
+  master_flavor       = "%s"
+  keypair             = openstack_compute_keypair_v2.keypair_1.name
+  master_count        = 1
+  node_count          = 1
+  floating_ip_enabled = true
+  disable_kubeconfig  = true
+}
+
+ephemeral "openstack_containerinfra_kubeconfig_v1" "kubeconfig_1" {
+  cluster_id = openstack_containerinfra_cluster_v1.cluster_1.id
+}
+`, osExtGwID, keypairName, clusterTemplateName, osMagnumImage, osExtGwID, clusterName, osMagnumFlavor, osMagnumFlavor)
+}
@@ -0,0 +1,381 @@
+package openstack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+var (
+	// openStackErrorHTMLHeadRegexp matches the head and the title of the HTML
+	// error bodies, which repeat the status code.
+	openStackErrorHTMLHeadRegexp = regexp.MustCompile(`(?is)<head>.*?</head>|<h1>.*?</h1>`)
+
+	openStackErrorHTMLTagRegexp = regexp.MustCompile(`<[^>]*>`)
+
+	// openStackErrorAttributeRegexps match the messages, which name the
+	// rejected attribute.
+	//
+	//nolint:gochecknoglobals // read-only list of compiled expressions
+	openStackErrorAttributeRegexps = []*regexp.Regexp{
+		// Neutron, Nova, Octavia and Keystone input validation.
+		regexp.MustCompile(`Invalid input for (?:field/attribute |field )?'?([A-Za-z_][\w.\-]*?)'?(?:[.:]\s|[.:]?$)`),
+		// JSON schema validation of additional properties.
+		regexp.MustCompile(`\('([A-Za-z_][\w.\-]*)' was unexpected\)`),
+	}
+)
+
+// openStackError holds the details of an error response of an OpenStack
+// service.
+type openStackError struct {
+	StatusCode int
+	Method     string
+	URL        string
+	RequestID  string
+	Type       string
+	Message    string
+	Detail     string
+	Attribute  string
+}
+
+// openStackErrorClass is the retry policy classification of an error.
+type openStackErrorClass int
+
+const (
+	// openStackErrorPermanent errors fail the same way when retried.
+	openStackErrorPermanent openStackErrorClass = iota
+
+	// openStackErrorRetryable errors are caused by a temporary failure or
+	// overload of the service.
+	openStackErrorRetryable
+
+	// openStackErrorQuota errors are caused by an exceeded project quota.
+	// They are not retried, since they need the quota to be raised or other
+	// resources to be freed.
+	openStackErrorQuota
+
+	// openStackErrorCapacity errors are caused by exhausted cloud resources,
+	// e.g. a subnet without free IP addresses or no valid host to schedule
+	// a server on. They may succeed, once concurrently deleted resources are
+	// freed.
+	openStackErrorCapacity
+
+	// openStackErrorConflict errors are caused by a concurrent operation on
+	// the same resource.
+	openStackErrorConflict
+)
+
+// novaFault is the error body of Nova, Cinder, Manila and Trove, which is
+// keyed by the fault type, e.g. badRequest or computeFault.
+type novaFault struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Details string `json:"details"`
+}
+
+// octaviaFault is the WSME error body of Octavia.
+type octaviaFault struct {
+	FaultCode   string `json:"faultcode"`
+	FaultString string `json:"faultstring"`
+	DebugInfo   any    `json:"debuginfo"`
+}
+
+// keystoneError is the error body of Keystone.
+type keystoneError struct {
+	Error struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Title   string `json:"title"`
+	} `json:"error"`
+}
+
+// magnumErrors is the error body of Magnum and other services, which
+// follow the API working group errors guideline.
+type magnumErrors struct {
+	Errors []struct {
+		Code   string `json:"code"`
+		Title  string `json:"title"`
+		Detail string `json:"detail"`
+	} `json:"errors"`
+}
+
+// decodeOpenStackError extracts the details of an unexpected response code
+// error. It returns false, when the error is not caused by an error
+// response of an OpenStack service.
+func decodeOpenStackError(err error) (*openStackError, bool) {
+	var e gophercloud.ErrUnexpectedResponseCode
+	if !errors.As(err, &e) {
+		return nil, false
+	}
+
+	oe := &openStackError{
+		StatusCode: e.Actual,
+		Method:     e.Method,
+		URL:        e.URL,
+	}
+
+	if e.ResponseHeader != nil {
+		oe.RequestID = e.ResponseHeader.Get("X-Openstack-Request-Id")
+		if oe.RequestID == "" {
+			oe.RequestID = e.ResponseHeader.Get("X-Compute-Request-Id")
+		}
+	}
+
+	decodeOpenStackErrorBody(oe, e.Body)
+
+	if oe.Message == "" {
+		oe.Message = http.StatusText(oe.StatusCode)
+	}
+
+	oe.Attribute = openStackErrorAttribute(oe.Message)
+
+	return oe, true
+}
+
+// decodeOpenStackErrorBody sets the type, message and detail of the error
+// from the error body of any of the supported services.
+func decodeOpenStackErrorBody(oe *openStackError, body []byte) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		oe.Message = openStackErrorText(body, oe.StatusCode)
+
+		return
+	}
+
+	if _, ok := raw["NeutronError"]; ok {
+		if e, err := decodeNeutronError(body); err == nil {
+			oe.Type = e.Type
+			oe.Message = e.Message
+			oe.Detail = e.Detail
+
+			return
+		}
+	}
+
+	if _, ok := raw["faultstring"]; ok {
+		var e octaviaFault
+		if err := json.Unmarshal(body, &e); err == nil {
+			oe.Type = e.FaultCode
+			oe.Message = e.FaultString
+
+			if v, ok := e.DebugInfo.(string); ok {
+				oe.Detail = v
+			}
+
+			return
+		}
+	}
+
+	if _, ok := raw["error"]; ok {
+		var e keystoneError
+		if err := json.Unmarshal(body, &e); err == nil && e.Error.Message != "" {
+			oe.Type = e.Error.Title
+			oe.Message = e.Error.Message
+
+			return
+		}
+	}
+
+	if _, ok := raw["errors"]; ok {
+		var e magnumErrors
+		if err := json.Unmarshal(body, &e); err == nil && len(e.Errors) > 0 {
+			oe.Type = e.Errors[0].Code
+			oe.Message = e.Errors[0].Title
+			oe.Detail = e.Errors[0].Detail
+
+			return
+		}
+	}
+
+	if v, ok := raw["message"]; ok {
+		// Designate and other services return a flat error body.
+		_ = json.Unmarshal(v, &oe.Message)
+
+		if v, ok := raw["type"]; ok {
+			_ = json.Unmarshal(v, &oe.Type)
+		}
+
+		return
+	}
+
+	if len(raw) == 1 {
+		for k, v := range raw {
+			var e novaFault
+			if err := json.Unmarshal(v, &e); err == nil && e.Message != "" {
+				oe.Type = k
+				oe.Message = e.Message
+				oe.Detail = e.Details
+			}
+		}
+
+		return
+	}
+
+	oe.Message = openStackErrorText(body, oe.StatusCode)
+}
+
+// classifyOpenStackError returns the retry policy classification of the
+// error. Errors, which are not caused by an error response of an OpenStack
+// service, are permanent.
+func classifyOpenStackError(err error) openStackErrorClass {
+	oe, ok := decodeOpenStackError(err)
+	if !ok {
+		return openStackErrorPermanent
+	}
+
+	return oe.class()
+}
+
+func (oe *openStackError) class() openStackErrorClass {
+	message := strings.ToLower(oe.Message + " " + oe.Type)
+
+	switch oe.Type {
+	case "IpAddressGenerationFailure", "ExternalIpAddressExhausted":
+		return openStackErrorCapacity
+	case "OverQuota":
+		return openStackErrorQuota
+	}
+
+	if strings.Contains(message, "no valid host") {
+		return openStackErrorCapacity
+	}
+
+	switch oe.StatusCode {
+	case http.StatusRequestEntityTooLarge: // 413
+		return openStackErrorQuota
+	case http.StatusForbidden, // 403
+		http.StatusConflict: // 409
+		if strings.Contains(message, "quota") {
+			return openStackErrorQuota
+		}
+
+		if oe.StatusCode == http.StatusConflict {
+			return openStackErrorConflict
+		}
+	case http.StatusTooManyRequests, // 429
+		http.StatusInternalServerError, // 500
+		http.StatusBadGateway,          // 502
+		http.StatusServiceUnavailable,  // 503
+		http.StatusGatewayTimeout:      // 504
+		return openStackErrorRetryable
+	}
+
+	return openStackErrorPermanent
+}
+
+// openStackErrorText returns the message of a plain text or HTML error
+// body, as returned by Glance and Swift.
+func openStackErrorText(body []byte, statusCode int) string {
+	text := openStackErrorHTMLHeadRegexp.ReplaceAllString(string(body), "")
+	text = openStackErrorHTMLTagRegexp.ReplaceAllString(text, " ")
+	text = strings.Join(strings.Fields(text), " ")
+
+	status := strconv.Itoa(statusCode) + " " + http.StatusText(statusCode)
+	text = strings.TrimSpace(strings.TrimPrefix(text, status))
+
+	return text
+}
+
+// openStackErrorAttribute returns the name of the attribute, which was
+// rejected by the service, if the message names one.
+func openStackErrorAttribute(message string) string {
+	for _, r := range openStackErrorAttributeRegexps {
+		if m := r.FindStringSubmatch(message); m != nil && m[1] != "operation" {
+			return m[1]
+		}
+	}
+
+	return ""
+}
+
+// diagOpenStackErrorf returns the error diagnostic with the summary and the
+// details of the error response of an OpenStack service. Other errors are
+// appended to the summary as is.
+func diagOpenStackErrorf(err error, format string, args ...any) diag.Diagnostics {
+	return diagOpenStackAttributeErrorf(err, nil, format, args...)
+}
+
+// diagOpenStackAttributeErrorf returns the error diagnostic like
+// diagOpenStackErrorf. attributes maps the field names of the API request to
+// the paths of the schema attributes. When the service rejected one of these
+// fields, the diagnostic points to its attribute.
+func diagOpenStackAttributeErrorf(err error, attributes map[string]cty.Path, format string, args ...any) diag.Diagnostics {
+	summary := fmt.Sprintf(format, args...)
+
+	oe, ok := decodeOpenStackError(err)
+	if !ok {
+		return diag.Errorf("%s: %s", summary, err)
+	}
+
+	d := oe.diagnostic(summary)
+
+	if path, ok := attributes[oe.Attribute]; ok {
+		d.AttributePath = path
+	}
+
+	return diag.Diagnostics{d}
+}
+
+// diagOpenStackQuotaErrorf returns the error diagnostic like
+// diagOpenStackErrorf. When the project quota is exceeded, the current quota
+// usage returned by the usage function is added to the detail.
+func diagOpenStackQuotaErrorf(ctx context.Context, err error, usage func(context.Context) (string, error), format string, args ...any) diag.Diagnostics {
+	diags := diagOpenStackErrorf(err, format, args...)
+
+	if classifyOpenStackError(err) != openStackErrorQuota {
+		return diags
+	}
+
+	v, e := usage(ctx)
+	if e != nil {
+		log.Printf("[DEBUG] Unable to retrieve the quota usage: %s", e)
+
+		return diags
+	}
+
+	diags[0].Detail += "\n\nCurrent quota usage:\n" + v
+
+	return diags
+}
+
+func (oe *openStackError) diagnostic(summary string) diag.Diagnostic {
+	var detail []string
+
+	if oe.Detail != "" && oe.Detail != oe.Message {
+		detail = append(detail, oe.Detail, "")
+	}
+
+	detail = append(detail, fmt.Sprintf("HTTP status: %d", oe.StatusCode))
+
+	if oe.Method != "" {
+		detail = append(detail, fmt.Sprintf("Request: %s %s", oe.Method, oe.URL))
+	}
+
+	if oe.RequestID != "" {
+		detail = append(detail, "Request ID: "+oe.RequestID)
+	}
+
+	if oe.Type != "" {
+		detail = append(detail, "Error type: "+oe.Type)
+	}
+
+	if oe.Attribute != "" {
+		detail = append(detail, "Attribute: "+oe.Attribute)
+	}
+
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("%s: %s", summary, oe.Message),
+		Detail:   strings.Join(detail, "\n"),
+	}
+}
@@ -0,0 +1,442 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	thclient "github.com/gophercloud/gophercloud/v2/testhelper/client"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testOpenStackResponseError(statusCode int, body string, header http.Header) error {
+	return gophercloud.ErrUnexpectedResponseCode{
+		URL:            "https://example.com/v2.0/networks",
+		Method:         http.MethodPost,
+		Expected:       []int{201},
+		Actual:         statusCode,
+		Body:           []byte(body),
+		ResponseHeader: header,
+	}
+}
+
+func TestUnitDecodeOpenStackError(t *testing.T) {
+	testCases := []struct {
+		name       string
+		statusCode int
+		body       string
+		expected   openStackError
+	}{
+		{
+			name:       "neutron",
+			statusCode: http.StatusBadRequest,
+			body:       `{"NeutronError": {"type": "HTTPBadRequest", "message": "Invalid input for cidr. Reason: 'foo' is not a valid IP subnet.", "detail": ""}}`,
+			expected: openStackError{
+				Type:      "HTTPBadRequest",
+				Message:   "Invalid input for cidr. Reason: 'foo' is not a valid IP subnet.",
+				Attribute: "cidr",
+			},
+		},
+		{
+			name:       "nova",
+			statusCode: http.StatusBadRequest,
+			body:       `{"badRequest": {"code": 400, "message": "Invalid input for field/attribute flavorRef. Value: foo. 'foo' does not match '^[0-9]+$'"}}`,
+			expected: openStackError{
+				Type:      "badRequest",
+				Message:   "Invalid input for field/attribute flavorRef. Value: foo. 'foo' does not match '^[0-9]+$'",
+				Attribute: "flavorRef",
+			},
+		},
+		{
+			name:       "nova compute fault",
+			statusCode: http.StatusInternalServerError,
+			body:       `{"computeFault": {"code": 500, "message": "Unexpected API Error.", "details": "Traceback"}}`,
+			expected: openStackError{
+				Type:    "computeFault",
+				Message: "Unexpected API Error.",
+				Detail:  "Traceback",
+			},
+		},
+		{
+			name:       "cinder",
+			statusCode: http.StatusRequestEntityTooLarge,
+			body:       `{"overLimit": {"code": 413, "message": "VolumeSizeExceedsAvailableQuota: Requested volume or snapshot exceeds allowed gigabytes quota."}}`,
+			expected: openStackError{
+				Type:    "overLimit",
+				Message: "VolumeSizeExceedsAvailableQuota: Requested volume or snapshot exceeds allowed gigabytes quota.",
+			},
+		},
+		{
+			name:       "octavia",
+			statusCode: http.StatusBadRequest,
+			body:       `{"faultcode": "Client", "faultstring": "Invalid input for field/attribute protocol. Value: 'FOO'. Value should be one of: HTTP, HTTPS, TCP, UDP", "debuginfo": null}`,
+			expected: openStackError{
+				Type:      "Client",
+				Message:   "Invalid input for field/attribute protocol. Value: 'FOO'. Value should be one of: HTTP, HTTPS, TCP, UDP",
+				Attribute: "protocol",
+			},
+		},
+		{
+			name:       "keystone",
+			statusCode: http.StatusBadRequest,
+			body:       `{"error": {"code": 400, "message": "Invalid input for field 'name': 'foo' is too long", "title": "Bad Request"}}`,
+			expected: openStackError{
+				Type:      "Bad Request",
+				Message:   "Invalid input for field 'name': 'foo' is too long",
+				Attribute: "name",
+			},
+		},
+		{
+			name:       "glance",
+			statusCode: http.StatusBadRequest,
+			body:       "<html>\n <head>\n  <title>400 Bad Request</title>\n </head>\n <body>\n  <h1>400 Bad Request</h1>\n  Provided object does not match schema 'image': Additional properties are not allowed ('foo' was unexpected)<br /><br />\n\n\n\n </body>\n</html>",
+			expected: openStackError{
+				Message:   "Provided object does not match schema 'image': Additional properties are not allowed ('foo' was unexpected)",
+				Attribute: "foo",
+			},
+		},
+		{
+			name:       "glance plain text",
+			statusCode: http.StatusConflict,
+			body:       "409 Conflict\n\nImage storage media is full\n\n   ",
+			expected: openStackError{
+				Message: "Image storage media is full",
+			},
+		},
+		{
+			name:       "designate",
+			statusCode: http.StatusBadRequest,
+			body:       `{"code": 400, "type": "invalid_object", "message": "Provided object does not match schema"}`,
+			expected: openStackError{
+				Type:    "invalid_object",
+				Message: "Provided object does not match schema",
+			},
+		},
+		{
+			name:       "magnum",
+			statusCode: http.StatusBadRequest,
+			body:       `{"errors": [{"request_id": "", "code": "client", "status": 400, "title": "Cluster name is invalid", "detail": "Cluster name foo is invalid"}]}`,
+			expected: openStackError{
+				Type:    "client",
+				Message: "Cluster name is invalid",
+				Detail:  "Cluster name foo is invalid",
+			},
+		},
+		{
+			name:       "empty body",
+			statusCode: http.StatusNotFound,
+			expected: openStackError{
+				Message: "Not Found",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set("X-Openstack-Request-Id", "req-1234")
+
+			err := fmt.Errorf("wrapped: %w", testOpenStackResponseError(tc.statusCode, tc.body, header))
+
+			actual, ok := decodeOpenStackError(err)
+			require.True(t, ok)
+
+			tc.expected.StatusCode = tc.statusCode
+			tc.expected.Method = http.MethodPost
+			tc.expected.URL = "https://example.com/v2.0/networks"
+			tc.expected.RequestID = "req-1234"
+			assert.Equal(t, &tc.expected, actual)
+		})
+	}
+}
+
+func TestUnitDecodeOpenStackErrorComputeRequestID(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-Compute-Request-Id", "req-5678")
+
+	actual, ok := decodeOpenStackError(testOpenStackResponseError(http.StatusConflict, "", header))
+	require.True(t, ok)
+	assert.Equal(t, "req-5678", actual.RequestID)
+
+	_, ok = decodeOpenStackError(errors.New("connection refused"))
+	assert.False(t, ok)
+}
+
+func TestUnitDiagOpenStackErrorf(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-Openstack-Request-Id", "req-1234")
+
+	err := testOpenStackResponseError(http.StatusBadRequest,
+		`{"NeutronError": {"type": "HTTPBadRequest", "message": "Invalid input for cidr. Reason: 'foo' is not a valid IP subnet.", "detail": ""}}`,
+		header)
+
+	expected := diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  "Error creating openstack_networking_subnet_v2: Invalid input for cidr. Reason: 'foo' is not a valid IP subnet.",
+			Detail:   "HTTP status: 400\nRequest: POST https://example.com/v2.0/networks\nRequest ID: req-1234\nError type: HTTPBadRequest\nAttribute: cidr",
+		},
+	}
+	assert.Equal(t, expected, diagOpenStackErrorf(err, "Error creating %s", "openstack_networking_subnet_v2"))
+
+	expected = diag.Errorf("Error creating openstack_networking_subnet_v2: connection refused")
+	assert.Equal(t, expected, diagOpenStackErrorf(errors.New("connection refused"), "Error creating openstack_networking_subnet_v2"))
+}
+
+func TestUnitDiagOpenStackAttributeErrorf(t *testing.T) {
+	err := testOpenStackResponseError(http.StatusBadRequest,
+		`{"NeutronError": {"type": "HTTPBadRequest", "message": "Invalid input for allocation_pools. Reason: Invalid data format for IP pool.", "detail": ""}}`,
+		nil)
+
+	attributes := map[string]cty.Path{
+		"cidr":             cty.GetAttrPath("cidr"),
+		"allocation_pools": cty.GetAttrPath("allocation_pool"),
+	}
+
+	diags := diagOpenStackAttributeErrorf(err, attributes, "Error creating openstack_networking_subnet_v2")
+	require.Len(t, diags, 1)
+	assert.Equal(t, cty.GetAttrPath("allocation_pool"), diags[0].AttributePath)
+
+	// Fields without a schema attribute are only named in the detail.
+	err = testOpenStackResponseError(http.StatusBadRequest,
+		`{"NeutronError": {"type": "HTTPBadRequest", "message": "Invalid input for ip_version. Reason: 5 is not in valid_values.", "detail": ""}}`,
+		nil)
+
+	diags = diagOpenStackAttributeErrorf(err, attributes, "Error creating openstack_networking_subnet_v2")
+	require.Len(t, diags, 1)
+	assert.Nil(t, diags[0].AttributePath)
+	assert.Contains(t, diags[0].Detail, "Attribute: ip_version")
+}
+
+func TestUnitClassifyOpenStackError(t *testing.T) {
+	testCases := []struct {
+		name       string
+		statusCode int
+		body       string
+		expected   openStackErrorClass
+	}{
+		{
+			name:     "not a response error",
+			expected: openStackErrorPermanent,
+		},
+		{
+			name:       "bad request",
+			statusCode: http.StatusBadRequest,
+			body:       `{"badRequest": {"code": 400, "message": "Invalid flavorRef provided."}}`,
+			expected:   openStackErrorPermanent,
+		},
+		{
+			name:       "service unavailable",
+			statusCode: http.StatusServiceUnavailable,
+			expected:   openStackErrorRetryable,
+		},
+		{
+			name:       "too many requests",
+			statusCode: http.StatusTooManyRequests,
+			expected:   openStackErrorRetryable,
+		},
+		{
+			name:       "conflict",
+			statusCode: http.StatusConflict,
+			body:       `{"NeutronError": {"type": "PortInUse", "message": "Port is in use.", "detail": ""}}`,
+			expected:   openStackErrorConflict,
+		},
+		{
+			name:       "neutron over quota",
+			statusCode: http.StatusConflict,
+			body:       `{"NeutronError": {"type": "OverQuota", "message": "Quota exceeded for resources: ['port'].", "detail": ""}}`,
+			expected:   openStackErrorQuota,
+		},
+		{
+			name:       "neutron ip address generation failure",
+			statusCode: http.StatusConflict,
+			body:       `{"NeutronError": {"type": "IpAddressGenerationFailure", "message": "No more IP addresses available on network.", "detail": ""}}`,
+			expected:   openStackErrorCapacity,
+		},
+		{
+			name:       "neutron external ip address exhausted",
+			statusCode: http.StatusBadRequest,
+			body:       `{"NeutronError": {"type": "ExternalIpAddressExhausted", "message": "Unable to find any IP address on external network.", "detail": ""}}`,
+			expected:   openStackErrorCapacity,
+		},
+		{
+			name:       "nova quota",
+			statusCode: http.StatusForbidden,
+			body:       `{"forbidden": {"code": 403, "message": "Quota exceeded for cores: Requested 4, but already used 20 of 20 cores"}}`,
+			expected:   openStackErrorQuota,
+		},
+		{
+			name:       "nova forbidden",
+			statusCode: http.StatusForbidden,
+			body:       `{"forbidden": {"code": 403, "message": "Policy doesn't allow os_compute_api:os-hypervisors:list to be performed."}}`,
+			expected:   openStackErrorPermanent,
+		},
+		{
+			name:       "cinder over limit",
+			statusCode: http.StatusRequestEntityTooLarge,
+			body:       `{"overLimit": {"code": 413, "message": "VolumeSizeExceedsAvailableQuota: Requested volume or snapshot exceeds allowed gigabytes quota."}}`,
+			expected:   openStackErrorQuota,
+		},
+		{
+			name:       "octavia quota",
+			statusCode: http.StatusForbidden,
+			body:       `{"faultcode": "Client", "faultstring": "Quota has been met for resources: Load Balancer", "debuginfo": null}`,
+			expected:   openStackErrorQuota,
+		},
+		{
+			name:       "no valid host",
+			statusCode: http.StatusInternalServerError,
+			body:       `{"computeFault": {"code": 500, "message": "No valid host was found. There are not enough hosts available."}}`,
+			expected:   openStackErrorCapacity,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := errors.New("connection refused")
+			if tc.statusCode != 0 {
+				err = testOpenStackResponseError(tc.statusCode, tc.body, nil)
+			}
+
+			assert.Equal(t, tc.expected, classifyOpenStackError(err))
+		})
+	}
+}
+
+func TestUnitCheckForRetryableError(t *testing.T) {
+	err := testOpenStackResponseError(http.StatusConflict, "", nil)
+	assert.True(t, checkForRetryableError(err).Retryable)
+
+	err = testOpenStackResponseError(http.StatusTooManyRequests, "", nil)
+	assert.True(t, checkForRetryableError(err).Retryable)
+
+	err = testOpenStackResponseError(http.StatusConflict,
+		`{"NeutronError": {"type": "OverQuota", "message": "Quota exceeded for resources: ['port'].", "detail": ""}}`, nil)
+	assert.False(t, checkForRetryableError(err).Retryable)
+
+	err = testOpenStackResponseError(http.StatusBadRequest, "", nil)
+	assert.False(t, checkForRetryableError(err).Retryable)
+
+	assert.False(t, checkForRetryableError(errors.New("connection refused")).Retryable)
+}
+
+func TestUnitRetryOn409(t *testing.T) {
+	err := testOpenStackResponseError(http.StatusConflict,
+		`{"NeutronError": {"type": "IpAddressGenerationFailure", "message": "No more IP addresses available on network.", "detail": ""}}`, nil)
+	assert.True(t, retryOn409(err))
+
+	err = testOpenStackResponseError(http.StatusConflict,
+		`{"NeutronError": {"type": "OverQuota", "message": "Quota exceeded for resources: ['floatingip'].", "detail": ""}}`, nil)
+	assert.False(t, retryOn409(err))
+
+	err = testOpenStackResponseError(http.StatusConflict,
+		`{"NeutronError": {"type": "RouterInUse", "message": "Router is in use.", "detail": ""}}`, nil)
+	assert.False(t, retryOn409(err))
+
+	err = testOpenStackResponseError(http.StatusConflict, "409 Conflict", nil)
+	assert.True(t, retryOn409(err))
+
+	err = testOpenStackResponseError(http.StatusNotFound, "", nil)
+	assert.True(t, retryOn409(err))
+
+	err = testOpenStackResponseError(http.StatusBadRequest,
+		`{"NeutronError": {"type": "ExternalIpAddressExhausted", "message": "Unable to find any IP address on external network.", "detail": ""}}`, nil)
+	assert.True(t, retryOn409(err))
+
+	err = testOpenStackResponseError(http.StatusBadRequest,
+		`{"NeutronError": {"type": "HTTPBadRequest", "message": "Invalid input for cidr.", "detail": ""}}`, nil)
+	assert.False(t, retryOn409(err))
+
+	// The body of a proxy in front of Neutron can't be decoded.
+	err = testOpenStackResponseError(http.StatusBadRequest, "<html><body>400 Bad Request</body></html>", nil)
+	assert.True(t, retryOn409(err))
+
+	assert.False(t, retryOn409(errors.New("connection refused")))
+}
+
+func TestUnitDiagOpenStackQuotaErrorf(t *testing.T) {
+	usage := func(context.Context) (string, error) {
+		return "cores: 20 used of 20", nil
+	}
+
+	err := testOpenStackResponseError(http.StatusForbidden,
+		`{"forbidden": {"code": 403, "message": "Quota exceeded for cores: Requested 4, but already used 20 of 20 cores"}}`, nil)
+
+	diags := diagOpenStackQuotaErrorf(t.Context(), err, usage, "Error creating OpenStack server")
+	require.Len(t, diags, 1)
+	assert.Equal(t, "Error creating OpenStack server: Quota exceeded for cores: Requested 4, but already used 20 of 20 cores", diags[0].Summary)
+	assert.Equal(t, "HTTP status: 403\nRequest: POST https://example.com/v2.0/networks\nError type: forbidden\n\nCurrent quota usage:\ncores: 20 used of 20", diags[0].Detail)
+
+	err = testOpenStackResponseError(http.StatusBadRequest,
+		`{"badRequest": {"code": 400, "message": "Invalid flavorRef provided."}}`, nil)
+
+	diags = diagOpenStackQuotaErrorf(t.Context(), err, usage, "Error creating OpenStack server")
+	require.Len(t, diags, 1)
+	assert.NotContains(t, diags[0].Detail, "Current quota usage")
+}
+
+func TestUnitComputeV2QuotaUsage(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodGet)
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"limits": {"rate": [], "absolute": {
+			"maxTotalInstances": 10, "totalInstancesUsed": 10,
+			"maxTotalCores": -1, "totalCoresUsed": 20,
+			"maxTotalRAMSize": 51200, "totalRAMUsed": 40960,
+			"maxServerGroups": 10, "totalServerGroupsUsed": 2
+		}}}`)
+	})
+
+	usage, err := computeV2QuotaUsage(thclient.ServiceClient(fakeServer))(t.Context())
+	require.NoError(t, err)
+
+	expected := "instances: 10 used of 10\ncores: 20 used of unlimited\nram: 40960 MiB used of 51200 MiB\nserver_groups: 2 used of 10"
+	assert.Equal(t, expected, usage)
+}
+
+func TestUnitComputeV2InstanceNoValidHost(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/servers/error", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"server": {"id": "error", "status": "ERROR", "fault": {"code": 500, "message": "No valid host was found. There are not enough hosts available."}}}`)
+	})
+
+	fakeServer.Mux.HandleFunc("/servers/active", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"server": {"id": "active", "status": "ACTIVE"}}`)
+	})
+
+	client := thclient.ServiceClient(fakeServer)
+
+	fault, ok := computeV2InstanceNoValidHost(t.Context(), client, "error")
+	assert.True(t, ok)
+	assert.Equal(t, "No valid host was found. There are not enough hosts available.", fault)
+
+	_, ok = computeV2InstanceNoValidHost(t.Context(), client, "active")
+	assert.False(t, ok)
+
+	_, ok = computeV2InstanceNoValidHost(t.Context(), client, "missing")
+	assert.False(t, ok)
+}
+
+func TestUnitComputeV2InstanceNoValidHostBackoff(t *testing.T) {
+	assert.Equal(t, 10*time.Second, computeV2InstanceNoValidHostBackoff(0))
+	assert.Equal(t, 20*time.Second, computeV2InstanceNoValidHostBackoff(1))
+	assert.Equal(t, 80*time.Second, computeV2InstanceNoValidHostBackoff(3))
+	assert.Equal(t, 2*time.Minute, computeV2InstanceNoValidHostBackoff(4))
+	assert.Equal(t, 2*time.Minute, computeV2InstanceNoValidHostBackoff(100))
+}
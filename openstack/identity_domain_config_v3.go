@@ -0,0 +1,143 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// Keystone only allows the identity and ldap groups to be configured
+// per domain.
+func getIdentityDomainConfigV3Groups() [2]string {
+	return [2]string{"identity", "ldap"}
+}
+
+// identityDomainConfigV3 represents the configuration of a domain-specific
+// identity driver, keyed by group and option name.
+type identityDomainConfigV3 map[string]map[string]any
+
+type identityDomainConfigV3Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets the response as a domain config.
+func (r identityDomainConfigV3Result) Extract() (identityDomainConfigV3, error) {
+	var s struct {
+		Config identityDomainConfigV3 `json:"config"`
+	}
+
+	err := r.ExtractInto(&s)
+
+	return s.Config, err
+}
+
+func identityDomainConfigV3URL(client *gophercloud.ServiceClient, domainID string, parts ...string) string {
+	return client.ServiceURL(append([]string{"domains", domainID, "config"}, parts...)...)
+}
+
+// identityDomainConfigV3Get retrieves the whole configuration of a domain.
+func identityDomainConfigV3Get(ctx context.Context, client *gophercloud.ServiceClient, domainID string) (r identityDomainConfigV3Result) {
+	resp, err := client.Get(ctx, identityDomainConfigV3URL(client, domainID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+// identityDomainConfigV3Create creates the configuration of a domain.
+func identityDomainConfigV3Create(ctx context.Context, client *gophercloud.ServiceClient, domainID string, c identityDomainConfigV3) (r identityDomainConfigV3Result) {
+	b := map[string]any{"config": c}
+	resp, err := client.Put(ctx, identityDomainConfigV3URL(client, domainID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+// identityDomainConfigV3Update merges the given options into the
+// configuration of a domain.
+func identityDomainConfigV3Update(ctx context.Context, client *gophercloud.ServiceClient, domainID string, c identityDomainConfigV3) (r identityDomainConfigV3Result) {
+	b := map[string]any{"config": c}
+	resp, err := client.Patch(ctx, identityDomainConfigV3URL(client, domainID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+// identityDomainConfigV3Delete deletes the configuration of a domain, or a
+// single group or option when parts are given.
+func identityDomainConfigV3Delete(ctx context.Context, client *gophercloud.ServiceClient, domainID string, parts ...string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(ctx, identityDomainConfigV3URL(client, domainID, parts...), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+// expandIdentityDomainConfigV3Group converts a group map from the schema into
+// request options. Keystone expects booleans for boolean options.
+func expandIdentityDomainConfigV3Group(raw map[string]any) map[string]any {
+	group := make(map[string]any, len(raw))
+
+	for k, v := range raw {
+		if v == "true" || v == "false" {
+			group[k] = v == "true"
+
+			continue
+		}
+
+		group[k] = v
+	}
+
+	return group
+}
+
+// flattenIdentityDomainConfigV3Group converts a group returned by Keystone
+// into a map of strings, skipping options which are not set.
+func flattenIdentityDomainConfigV3Group(group map[string]any) map[string]string {
+	m := make(map[string]string, len(group))
+
+	for k, v := range group {
+		if v == nil {
+			continue
+		}
+
+		m[k] = fmt.Sprint(v)
+	}
+
+	return m
+}
+
+func expandIdentityDomainConfigV3(identity, ldap map[string]any, ldapPassword string) identityDomainConfigV3 {
+	c := make(identityDomainConfigV3)
+
+	if len(identity) > 0 {
+		c["identity"] = expandIdentityDomainConfigV3Group(identity)
+	}
+
+	if len(ldap) > 0 || ldapPassword != "" {
+		c["ldap"] = expandIdentityDomainConfigV3Group(ldap)
+	}
+
+	if ldapPassword != "" {
+		c["ldap"]["password"] = ldapPassword
+	}
+
+	return c
+}
+
+// identityDomainConfigV3RemovedOptions returns the options which are present
+// in the old group, but not in the new one.
+func identityDomainConfigV3RemovedOptions(oldGroup, newGroup map[string]any) []string {
+	var removed []string
+
+	for k := range oldGroup {
+		if _, ok := newGroup[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+
+	return removed
+}
@@ -0,0 +1,66 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitExpandIdentityDomainConfigV3(t *testing.T) {
+	identity := map[string]any{
+		"driver": "ldap",
+	}
+	ldap := map[string]any{
+		"url":                    "ldap://localhost",
+		"user_enabled_emulation": "true",
+	}
+
+	expected := identityDomainConfigV3{
+		"identity": {
+			"driver": "ldap",
+		},
+		"ldap": {
+			"url":                    "ldap://localhost",
+			"user_enabled_emulation": true,
+			"password":               "secret",
+		},
+	}
+
+	actual := expandIdentityDomainConfigV3(identity, ldap, "secret")
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitExpandIdentityDomainConfigV3Empty(t *testing.T) {
+	actual := expandIdentityDomainConfigV3(map[string]any{}, map[string]any{}, "")
+	assert.Empty(t, actual)
+}
+
+func TestUnitFlattenIdentityDomainConfigV3Group(t *testing.T) {
+	group := map[string]any{
+		"url":                    "ldap://localhost",
+		"user_enabled_emulation": true,
+		"page_size":              float64(100),
+		"suffix":                 nil,
+	}
+
+	expected := map[string]string{
+		"url":                    "ldap://localhost",
+		"user_enabled_emulation": "true",
+		"page_size":              "100",
+	}
+
+	actual := flattenIdentityDomainConfigV3Group(group)
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitIdentityDomainConfigV3RemovedOptions(t *testing.T) {
+	oldGroup := map[string]any{
+		"url":    "ldap://localhost",
+		"suffix": "dc=example,dc=com",
+	}
+	newGroup := map[string]any{
+		"url": "ldaps://localhost",
+	}
+
+	assert.Equal(t, []string{"suffix"}, identityDomainConfigV3RemovedOptions(oldGroup, newGroup))
+}
@@ -0,0 +1,243 @@
+package openstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/federation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+// identityProviderV3 represents a Keystone federated identity provider.
+type identityProviderV3 struct {
+	ID               string   `json:"id"`
+	DomainID         string   `json:"domain_id"`
+	Description      string   `json:"description"`
+	Enabled          bool     `json:"enabled"`
+	RemoteIDs        []string `json:"remote_ids"`
+	AuthorizationTTL *int     `json:"authorization_ttl"`
+}
+
+// identityProviderV3CreateOpts represents the attributes used when creating
+// or updating an identity provider.
+type identityProviderV3CreateOpts struct {
+	DomainID         string    `json:"domain_id,omitempty"`
+	Description      *string   `json:"description,omitempty"`
+	Enabled          *bool     `json:"enabled,omitempty"`
+	RemoteIDs        *[]string `json:"remote_ids,omitempty"`
+	AuthorizationTTL *int      `json:"authorization_ttl,omitempty"`
+}
+
+type identityProviderV3Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets the response as an identity provider.
+func (r identityProviderV3Result) Extract() (*identityProviderV3, error) {
+	var s struct {
+		IdentityProvider *identityProviderV3 `json:"identity_provider"`
+	}
+
+	err := r.ExtractInto(&s)
+
+	return s.IdentityProvider, err
+}
+
+func identityProviderV3URL(client *gophercloud.ServiceClient, idpID string, parts ...string) string {
+	return client.ServiceURL(append([]string{"OS-FEDERATION", "identity_providers", idpID}, parts...)...)
+}
+
+func identityProviderV3Create(ctx context.Context, client *gophercloud.ServiceClient, idpID string, opts identityProviderV3CreateOpts) (r identityProviderV3Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "identity_provider")
+	if err != nil {
+		r.Err = err
+
+		return
+	}
+
+	resp, err := client.Put(ctx, identityProviderV3URL(client, idpID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func identityProviderV3Get(ctx context.Context, client *gophercloud.ServiceClient, idpID string) (r identityProviderV3Result) {
+	resp, err := client.Get(ctx, identityProviderV3URL(client, idpID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func identityProviderV3Update(ctx context.Context, client *gophercloud.ServiceClient, idpID string, opts identityProviderV3CreateOpts) (r identityProviderV3Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "identity_provider")
+	if err != nil {
+		r.Err = err
+
+		return
+	}
+
+	resp, err := client.Patch(ctx, identityProviderV3URL(client, idpID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func identityProviderV3Delete(ctx context.Context, client *gophercloud.ServiceClient, idpID string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(ctx, identityProviderV3URL(client, idpID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+// identityProtocolV3 represents a federation protocol of an identity provider.
+type identityProtocolV3 struct {
+	ID                string `json:"id"`
+	MappingID         string `json:"mapping_id"`
+	RemoteIDAttribute string `json:"remote_id_attribute"`
+}
+
+// identityProtocolV3CreateOpts represents the attributes used when creating
+// or updating a federation protocol.
+type identityProtocolV3CreateOpts struct {
+	MappingID         string `json:"mapping_id" required:"true"`
+	RemoteIDAttribute string `json:"remote_id_attribute,omitempty"`
+}
+
+type identityProtocolV3Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets the response as a federation protocol.
+func (r identityProtocolV3Result) Extract() (*identityProtocolV3, error) {
+	var s struct {
+		Protocol *identityProtocolV3 `json:"protocol"`
+	}
+
+	err := r.ExtractInto(&s)
+
+	return s.Protocol, err
+}
+
+func identityProtocolV3Create(ctx context.Context, client *gophercloud.ServiceClient, idpID, protocolID string, opts identityProtocolV3CreateOpts) (r identityProtocolV3Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "protocol")
+	if err != nil {
+		r.Err = err
+
+		return
+	}
+
+	resp, err := client.Put(ctx, identityProviderV3URL(client, idpID, "protocols", protocolID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func identityProtocolV3Get(ctx context.Context, client *gophercloud.ServiceClient, idpID, protocolID string) (r identityProtocolV3Result) {
+	resp, err := client.Get(ctx, identityProviderV3URL(client, idpID, "protocols", protocolID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func identityProtocolV3Update(ctx context.Context, client *gophercloud.ServiceClient, idpID, protocolID string, opts identityProtocolV3CreateOpts) (r identityProtocolV3Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "protocol")
+	if err != nil {
+		r.Err = err
+
+		return
+	}
+
+	resp, err := client.Patch(ctx, identityProviderV3URL(client, idpID, "protocols", protocolID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func identityProtocolV3Delete(ctx context.Context, client *gophercloud.ServiceClient, idpID, protocolID string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(ctx, identityProviderV3URL(client, idpID, "protocols", protocolID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+// identityMappingV3Opts passes the mapping rules through as they were
+// configured, so that attributes unknown to Gophercloud are not dropped.
+type identityMappingV3Opts struct {
+	Rules []any `json:"rules"`
+}
+
+// ToMappingCreateMap builds a request body from identityMappingV3Opts.
+func (opts identityMappingV3Opts) ToMappingCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "mapping")
+}
+
+// ToMappingUpdateMap builds a request body from identityMappingV3Opts.
+func (opts identityMappingV3Opts) ToMappingUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "mapping")
+}
+
+// validateIdentityMappingV3Rules ensures that the rules are a JSON array of
+// objects, each having a local and a remote part.
+func validateIdentityMappingV3Rules(v any, k string) ([]string, []error) {
+	ws, errs := validateJSONArray(v, k)
+	if len(errs) > 0 {
+		return ws, errs
+	}
+
+	var rules []map[string]any
+
+	if err := json.Unmarshal([]byte(v.(string)), &rules); err != nil {
+		return ws, []error{fmt.Errorf("%q must be a JSON array of objects: %w", k, err)}
+	}
+
+	for i, rule := range rules {
+		for _, part := range []string{"local", "remote"} {
+			if _, ok := rule[part].([]any); !ok {
+				errs = append(errs, fmt.Errorf("%q rule %d must have a %q list", k, i, part))
+			}
+		}
+	}
+
+	return ws, errs
+}
+
+func expandIdentityMappingV3Rules(raw string) (identityMappingV3Opts, error) {
+	var opts identityMappingV3Opts
+
+	if err := json.Unmarshal([]byte(raw), &opts.Rules); err != nil {
+		return opts, fmt.Errorf("Unable to parse rules: %w", err)
+	}
+
+	return opts, nil
+}
+
+// extractIdentityMappingV3Rules returns the rules of a mapping as a
+// normalized JSON string.
+func extractIdentityMappingV3Rules(r federation.GetMappingResult) (string, error) {
+	var s struct {
+		Mapping struct {
+			Rules []any `json:"rules"`
+		} `json:"mapping"`
+	}
+
+	if err := r.ExtractInto(&s); err != nil {
+		return "", err
+	}
+
+	rules, err := json.Marshal(s.Mapping.Rules)
+	if err != nil {
+		return "", err
+	}
+
+	return structure.NormalizeJsonString(string(rules))
+}
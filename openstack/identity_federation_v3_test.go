@@ -0,0 +1,50 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitValidateIdentityMappingV3Rules(t *testing.T) {
+	valid := `[{"local": [{"user": {"name": "{0}"}}], "remote": [{"type": "REMOTE_USER"}]}]`
+
+	_, errs := validateIdentityMappingV3Rules(valid, "rules")
+	assert.Empty(t, errs)
+
+	for _, invalid := range []string{
+		``,
+		`{"local": [], "remote": []}`,
+		`["foo"]`,
+		`[{"local": []}]`,
+		`[{"local": {}, "remote": []}]`,
+	} {
+		_, errs := validateIdentityMappingV3Rules(invalid, "rules")
+		assert.NotEmpty(t, errs, invalid)
+	}
+}
+
+func TestUnitExpandIdentityMappingV3Rules(t *testing.T) {
+	opts, err := expandIdentityMappingV3Rules(`[{"local": [{"group": {"id": "abc"}}], "remote": [{"type": "HTTP_OIDC_GROUPS", "any_one_of": ["admins"]}]}]`)
+	require.NoError(t, err)
+
+	expected := map[string]any{
+		"mapping": map[string]any{
+			"rules": []any{
+				map[string]any{
+					"local": []any{
+						map[string]any{"group": map[string]any{"id": "abc"}},
+					},
+					"remote": []any{
+						map[string]any{"type": "HTTP_OIDC_GROUPS", "any_one_of": []any{"admins"}},
+					},
+				},
+			},
+		},
+	}
+
+	actual, err := opts.ToMappingCreateMap()
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
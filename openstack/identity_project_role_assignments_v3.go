@@ -0,0 +1,166 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/osinherit"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/roles"
+)
+
+// identityProjectRoleAssignmentV3 is a role assignment of a user or a group
+// on a project.
+type identityProjectRoleAssignmentV3 struct {
+	UserID    string
+	GroupID   string
+	RoleID    string
+	Inherited bool
+}
+
+func expandIdentityProjectRoleAssignmentsV3(raw []any) ([]identityProjectRoleAssignmentV3, error) {
+	res := make([]identityProjectRoleAssignmentV3, 0, len(raw))
+
+	for _, v := range raw {
+		m := v.(map[string]any)
+
+		a := identityProjectRoleAssignmentV3{
+			UserID:    m["user_id"].(string),
+			GroupID:   m["group_id"].(string),
+			RoleID:    m["role_id"].(string),
+			Inherited: m["inherited"].(bool),
+		}
+
+		if (a.UserID == "") == (a.GroupID == "") {
+			return nil, errors.New("Exactly one of user_id or group_id must be set in each assignment")
+		}
+
+		res = append(res, a)
+	}
+
+	return res, nil
+}
+
+func flattenIdentityProjectRoleAssignmentsV3(assignments []identityProjectRoleAssignmentV3) []map[string]any {
+	res := make([]map[string]any, 0, len(assignments))
+
+	for _, a := range assignments {
+		res = append(res, map[string]any{
+			"user_id":   a.UserID,
+			"group_id":  a.GroupID,
+			"role_id":   a.RoleID,
+			"inherited": a.Inherited,
+		})
+	}
+
+	return res
+}
+
+// diffIdentityProjectRoleAssignmentsV3 returns the assignments to create and
+// the ones to remove, in order to turn the current assignments into the
+// desired ones.
+func diffIdentityProjectRoleAssignmentsV3(current, desired []identityProjectRoleAssignmentV3) ([]identityProjectRoleAssignmentV3, []identityProjectRoleAssignmentV3) {
+	currentSet := make(map[identityProjectRoleAssignmentV3]struct{}, len(current))
+	for _, a := range current {
+		currentSet[a] = struct{}{}
+	}
+
+	desiredSet := make(map[identityProjectRoleAssignmentV3]struct{}, len(desired))
+	for _, a := range desired {
+		desiredSet[a] = struct{}{}
+	}
+
+	var toAssign, toUnassign []identityProjectRoleAssignmentV3
+
+	for _, a := range desired {
+		if _, ok := currentSet[a]; !ok {
+			toAssign = append(toAssign, a)
+		}
+	}
+
+	for _, a := range current {
+		if _, ok := desiredSet[a]; !ok {
+			toUnassign = append(toUnassign, a)
+		}
+	}
+
+	return toAssign, toUnassign
+}
+
+// identityProjectRoleAssignmentsV3List returns the user and group role
+// assignments made directly on a project, including the inherited ones.
+func identityProjectRoleAssignmentsV3List(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]identityProjectRoleAssignmentV3, error) {
+	listOpts := identityRoleAssignmentsV3ListOpts{
+		ListAssignmentsOpts: roles.ListAssignmentsOpts{
+			ScopeProjectID: projectID,
+		},
+	}
+
+	allPages, err := roles.ListAssignments(client, listOpts).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allAssignments, err := extractIdentityRoleAssignmentsV3(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]identityProjectRoleAssignmentV3, 0, len(allAssignments))
+
+	for _, a := range allAssignments {
+		if a.Scope.Project.ID != projectID {
+			continue
+		}
+
+		res = append(res, identityProjectRoleAssignmentV3{
+			UserID:    a.User.ID,
+			GroupID:   a.Group.ID,
+			RoleID:    a.Role.ID,
+			Inherited: a.Scope.InheritedTo != "",
+		})
+	}
+
+	return res, nil
+}
+
+func identityProjectRoleAssignmentV3Assign(ctx context.Context, client *gophercloud.ServiceClient, projectID string, a identityProjectRoleAssignmentV3) error {
+	if a.Inherited {
+		return osinherit.Assign(ctx, client, a.RoleID, osinherit.AssignOpts{
+			UserID:    a.UserID,
+			GroupID:   a.GroupID,
+			ProjectID: projectID,
+		}).ExtractErr()
+	}
+
+	return roles.Assign(ctx, client, a.RoleID, roles.AssignOpts{
+		UserID:    a.UserID,
+		GroupID:   a.GroupID,
+		ProjectID: projectID,
+	}).ExtractErr()
+}
+
+func identityProjectRoleAssignmentV3Unassign(ctx context.Context, client *gophercloud.ServiceClient, projectID string, a identityProjectRoleAssignmentV3) error {
+	var err error
+
+	if a.Inherited {
+		err = osinherit.Unassign(ctx, client, a.RoleID, osinherit.UnassignOpts{
+			UserID:    a.UserID,
+			GroupID:   a.GroupID,
+			ProjectID: projectID,
+		}).ExtractErr()
+	} else {
+		err = roles.Unassign(ctx, client, a.RoleID, roles.UnassignOpts{
+			UserID:    a.UserID,
+			GroupID:   a.GroupID,
+			ProjectID: projectID,
+		}).ExtractErr()
+	}
+
+	if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,72 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitExpandIdentityProjectRoleAssignmentsV3(t *testing.T) {
+	raw := []any{
+		map[string]any{
+			"user_id":   "user",
+			"group_id":  "",
+			"role_id":   "role",
+			"inherited": false,
+		},
+		map[string]any{
+			"user_id":   "",
+			"group_id":  "group",
+			"role_id":   "role",
+			"inherited": true,
+		},
+	}
+
+	expected := []identityProjectRoleAssignmentV3{
+		{UserID: "user", RoleID: "role"},
+		{GroupID: "group", RoleID: "role", Inherited: true},
+	}
+
+	actual, err := expandIdentityProjectRoleAssignmentsV3(raw)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitExpandIdentityProjectRoleAssignmentsV3Invalid(t *testing.T) {
+	for _, raw := range []map[string]any{
+		{"user_id": "", "group_id": "", "role_id": "role", "inherited": false},
+		{"user_id": "user", "group_id": "group", "role_id": "role", "inherited": false},
+	} {
+		_, err := expandIdentityProjectRoleAssignmentsV3([]any{raw})
+		require.Error(t, err)
+	}
+}
+
+func TestUnitDiffIdentityProjectRoleAssignmentsV3(t *testing.T) {
+	current := []identityProjectRoleAssignmentV3{
+		{UserID: "user1", RoleID: "member"},
+		{UserID: "user2", RoleID: "member"},
+		{GroupID: "group", RoleID: "reader", Inherited: true},
+	}
+
+	desired := []identityProjectRoleAssignmentV3{
+		{UserID: "user1", RoleID: "member"},
+		{GroupID: "group", RoleID: "reader"},
+		{UserID: "user3", RoleID: "admin"},
+	}
+
+	expectedAssign := []identityProjectRoleAssignmentV3{
+		{GroupID: "group", RoleID: "reader"},
+		{UserID: "user3", RoleID: "admin"},
+	}
+
+	expectedUnassign := []identityProjectRoleAssignmentV3{
+		{UserID: "user2", RoleID: "member"},
+		{GroupID: "group", RoleID: "reader", Inherited: true},
+	}
+
+	toAssign, toUnassign := diffIdentityProjectRoleAssignmentsV3(current, desired)
+	assert.Equal(t, expectedAssign, toAssign)
+	assert.Equal(t, expectedUnassign, toUnassign)
+}
@@ -0,0 +1,101 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/roles"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// identityRoleAssignmentsV3ListOpts extends roles.ListAssignmentsOpts with
+// the filter for assignments inherited to the projects of a scope.
+type identityRoleAssignmentsV3ListOpts struct {
+	roles.ListAssignmentsOpts
+
+	// InheritedTo filters the results by the OS-INHERIT extension scope,
+	// which is always "projects".
+	InheritedTo string `q:"scope.OS-INHERIT:inherited_to"`
+}
+
+// ToRolesListAssignmentsQuery formats identityRoleAssignmentsV3ListOpts into
+// a query string.
+func (opts identityRoleAssignmentsV3ListOpts) ToRolesListAssignmentsQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts.ListAssignmentsOpts)
+	if err != nil {
+		return "", err
+	}
+
+	if opts.InheritedTo != "" {
+		params := q.Query()
+		params.Add("scope.OS-INHERIT:inherited_to", opts.InheritedTo)
+		q.RawQuery = params.Encode()
+	}
+
+	return q.String(), nil
+}
+
+// identityRoleAssignmentV3 is a role assignment which also has the system
+// scope and the inheritance of the scope, unlike roles.RoleAssignment.
+type identityRoleAssignmentV3 struct {
+	Role  roles.AssignedRole            `json:"role"`
+	User  roles.User                    `json:"user"`
+	Group roles.Group                   `json:"group"`
+	Scope identityRoleAssignmentV3Scope `json:"scope"`
+}
+
+type identityRoleAssignmentV3Scope struct {
+	Domain      roles.Domain  `json:"domain"`
+	Project     roles.Project `json:"project"`
+	InheritedTo string        `json:"OS-INHERIT:inherited_to"`
+	System      struct {
+		All bool `json:"all"`
+	} `json:"system"`
+}
+
+func extractIdentityRoleAssignmentsV3(r pagination.Page) ([]identityRoleAssignmentV3, error) {
+	var s []identityRoleAssignmentV3
+
+	err := r.(roles.RoleAssignmentPage).ExtractIntoSlicePtr(&s, "role_assignments")
+
+	return s, err
+}
+
+// filterIdentityRoleAssignmentsV3Inherited keeps the assignments which are,
+// or are not, inherited to the projects of their scope. The API only allows
+// to filter the inherited ones.
+func filterIdentityRoleAssignmentsV3Inherited(assignments []identityRoleAssignmentV3, inherited bool) []identityRoleAssignmentV3 {
+	res := make([]identityRoleAssignmentV3, 0, len(assignments))
+
+	for _, a := range assignments {
+		if (a.Scope.InheritedTo != "") == inherited {
+			res = append(res, a)
+		}
+	}
+
+	return res
+}
+
+func flattenIdentityRoleAssignmentsV3(assignments []identityRoleAssignmentV3) []map[string]any {
+	res := make([]map[string]any, 0, len(assignments))
+
+	for _, a := range assignments {
+		res = append(res, map[string]any{
+			"role_id":           a.Role.ID,
+			"role_name":         a.Role.Name,
+			"user_id":           a.User.ID,
+			"user_name":         a.User.Name,
+			"user_domain_id":    a.User.Domain.ID,
+			"group_id":          a.Group.ID,
+			"group_name":        a.Group.Name,
+			"group_domain_id":   a.Group.Domain.ID,
+			"project_id":        a.Scope.Project.ID,
+			"project_name":      a.Scope.Project.Name,
+			"project_domain_id": a.Scope.Project.Domain.ID,
+			"domain_id":         a.Scope.Domain.ID,
+			"domain_name":       a.Scope.Domain.Name,
+			"system":            a.Scope.System.All,
+			"inherited":         a.Scope.InheritedTo != "",
+		})
+	}
+
+	return res
+}
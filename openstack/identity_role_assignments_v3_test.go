@@ -0,0 +1,89 @@
+package openstack
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/roles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitIdentityRoleAssignmentsV3ListOptsQuery(t *testing.T) {
+	effective := true
+
+	opts := identityRoleAssignmentsV3ListOpts{
+		ListAssignmentsOpts: roles.ListAssignmentsOpts{
+			UserID:    "user",
+			Effective: &effective,
+		},
+		InheritedTo: "projects",
+	}
+
+	expected := "?effective=true&scope.OS-INHERIT%3Ainherited_to=projects&user.id=user"
+
+	actual, err := opts.ToRolesListAssignmentsQuery()
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitFlattenIdentityRoleAssignmentsV3(t *testing.T) {
+	raw := `[
+		{
+			"role": {"id": "role", "name": "member"},
+			"user": {"id": "user", "name": "alice", "domain": {"id": "default"}},
+			"scope": {"project": {"id": "project", "name": "demo", "domain": {"id": "default"}}, "OS-INHERIT:inherited_to": "projects"}
+		},
+		{
+			"role": {"id": "admin"},
+			"group": {"id": "group"},
+			"scope": {"system": {"all": true}}
+		}
+	]`
+
+	var assignments []identityRoleAssignmentV3
+	require.NoError(t, json.Unmarshal([]byte(raw), &assignments))
+
+	expected := []map[string]any{
+		{
+			"role_id":           "role",
+			"role_name":         "member",
+			"user_id":           "user",
+			"user_name":         "alice",
+			"user_domain_id":    "default",
+			"group_id":          "",
+			"group_name":        "",
+			"group_domain_id":   "",
+			"project_id":        "project",
+			"project_name":      "demo",
+			"project_domain_id": "default",
+			"domain_id":         "",
+			"domain_name":       "",
+			"system":            false,
+			"inherited":         true,
+		},
+		{
+			"role_id":           "admin",
+			"role_name":         "",
+			"user_id":           "",
+			"user_name":         "",
+			"user_domain_id":    "",
+			"group_id":          "group",
+			"group_name":        "",
+			"group_domain_id":   "",
+			"project_id":        "",
+			"project_name":      "",
+			"project_domain_id": "",
+			"domain_id":         "",
+			"domain_name":       "",
+			"system":            true,
+			"inherited":         false,
+		},
+	}
+
+	assert.Equal(t, expected, flattenIdentityRoleAssignmentsV3(assignments))
+
+	notInherited := filterIdentityRoleAssignmentsV3Inherited(assignments, false)
+	require.Len(t, notInherited, 1)
+	assert.Equal(t, "admin", notInherited[0].Role.ID)
+}
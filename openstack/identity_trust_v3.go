@@ -0,0 +1,23 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/trusts"
+)
+
+func flattenIdentityTrustV3Roles(roles []trusts.Role) []string {
+	res := make([]string, 0, len(roles))
+	for _, role := range roles {
+		res = append(res, role.Name)
+	}
+
+	return res
+}
+
+func expandIdentityTrustV3Roles(roles []any) []trusts.Role {
+	res := make([]trusts.Role, 0, len(roles))
+	for _, role := range roles {
+		res = append(res, trusts.Role{Name: role.(string)})
+	}
+
+	return res
+}
@@ -0,0 +1,38 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/trusts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitFlattenIdentityTrustV3Roles(t *testing.T) {
+	roles := []trusts.Role{
+		{
+			ID:   "123",
+			Name: "foo",
+		},
+		{
+			ID:   "321",
+			Name: "bar",
+		},
+	}
+
+	expected := []string{"foo", "bar"}
+
+	actual := flattenIdentityTrustV3Roles(roles)
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitExpandIdentityTrustV3Roles(t *testing.T) {
+	roles := []any{"foo", "bar"}
+
+	expected := []trusts.Role{
+		{Name: "foo"},
+		{Name: "bar"},
+	}
+
+	actual := expandIdentityTrustV3Roles(roles)
+	assert.Equal(t, expected, actual)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
 	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/members"
+	imagesutils "github.com/gophercloud/utils/v2/openstack/image/v2/images"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/klauspost/compress/zstd"
@@ -459,3 +460,36 @@ func dataSourceValidateImageSortFilter(v any, _ string) (ws []string, errors []e
 
 	return ws, errors
 }
+
+// imagesImageV2IDFromName returns the ID of the image with the given name
+// using the lookup cache of the provider.
+func imagesImageV2IDFromName(ctx context.Context, cache *lookupCache, client *gophercloud.ServiceClient, name string) (string, error) {
+	v, err := cache.lookup(client, lookupCacheImage, "name", name, func() (string, string, any, error) {
+		id, err := imagesutils.IDFromName(ctx, client, name)
+
+		return id, name, id, err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return v.(string), nil
+}
+
+// imagesImageV2Name returns the name of the image with the given ID using
+// the lookup cache of the provider.
+func imagesImageV2Name(ctx context.Context, cache *lookupCache, client *gophercloud.ServiceClient, id string) (string, error) {
+	v, err := cache.lookup(client, lookupCacheImage, "id", id, func() (string, string, any, error) {
+		image, err := images.Get(ctx, client, id).Extract()
+		if err != nil {
+			return "", "", nil, err
+		}
+
+		return image.ID, image.Name, image.Name, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return v.(string), nil
+}
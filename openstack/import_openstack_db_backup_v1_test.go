@@ -0,0 +1,34 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatabaseV1Backup_importBasic(t *testing.T) {
+	resourceName := "openstack_db_backup_v1.backup_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDatabaseV1BackupDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1BackupBasic(),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"swift_container",
+				},
+			},
+		},
+	})
+}
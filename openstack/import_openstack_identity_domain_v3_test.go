@@ -0,0 +1,34 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIdentityV3Domain_importBasic(t *testing.T) {
+	resourceName := "openstack_identity_domain_v3.domain_1"
+
+	domainName := "ACCPTTEST-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIdentityV3DomainDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3DomainBasic(domainName),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
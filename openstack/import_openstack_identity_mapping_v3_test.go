@@ -0,0 +1,34 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIdentityV3Mapping_importBasic(t *testing.T) {
+	resourceName := "openstack_identity_mapping_v3.mapping_1"
+
+	mappingName := "ACCPTTEST-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIdentityV3MappingDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3MappingBasic(mappingName),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIdentityV3ProjectRoleAssignments_importBasic(t *testing.T) {
+	resourceName := "openstack_identity_project_role_assignments_v3.assignments_1"
+
+	name := "ACCPTTEST-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIdentityV3ProjectRoleAssignmentsDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3ProjectRoleAssignmentsBasic(name),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,32 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLBV2AvailabilityZone_importBasic(t *testing.T) {
+	resourceName := "openstack_lb_availability_zone_v2.az_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2AvailabilityZoneDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLbV2AvailabilityZone,
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,35 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSFSV2ShareReplica_importBasic(t *testing.T) {
+	resourceName := "openstack_sharedfilesystem_share_replica_v2.replica_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareReplicaDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareReplicaConfigBasic,
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"promote",
+				},
+			},
+		},
+	})
+}
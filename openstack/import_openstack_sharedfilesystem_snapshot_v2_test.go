@@ -0,0 +1,32 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSFSV2Snapshot_importBasic(t *testing.T) {
+	resourceName := "openstack_sharedfilesystem_snapshot_v2.snapshot_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2SnapshotDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2SnapshotConfigBasic,
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
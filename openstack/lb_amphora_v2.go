@@ -0,0 +1,51 @@
+package openstack
+
+import (
+	"context"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/amphorae"
+)
+
+const (
+	lbAmphoraV2ActionFailover  = "failover"
+	lbAmphoraV2ActionConfigure = "configure"
+)
+
+// lbAmphoraV2Configure updates the configuration of an amphora agent.
+// Gophercloud doesn't support the amphora configuration.
+func lbAmphoraV2Configure(ctx context.Context, client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	resp, err := client.Put(ctx, client.ServiceURL("octavia", "amphorae", id, "config"), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func flattenLBAmphoraeV2(amphorae []amphorae.Amphora) []map[string]any {
+	a := make([]map[string]any, len(amphorae))
+
+	for i, amphora := range amphorae {
+		a[i] = map[string]any{
+			"id":              amphora.ID,
+			"loadbalancer_id": amphora.LoadbalancerID,
+			"compute_id":      amphora.ComputeID,
+			"lb_network_ip":   amphora.LBNetworkIP,
+			"ha_ip":           amphora.HAIP,
+			"ha_port_id":      amphora.HAPortID,
+			"vrrp_ip":         amphora.VRRPIP,
+			"vrrp_port_id":    amphora.VRRPPortID,
+			"role":            amphora.Role,
+			"status":          amphora.Status,
+			"image_id":        amphora.ImageID,
+			"cached_zone":     amphora.CachedZone,
+			"cert_expiration": amphora.CertExpiration.Format(time.RFC3339),
+			"created_at":      amphora.CreatedAt.Format(time.RFC3339),
+			"updated_at":      amphora.UpdatedAt.Format(time.RFC3339),
+		}
+	}
+
+	return a
+}
@@ -0,0 +1,123 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/amphorae"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	thclient "github.com/gophercloud/gophercloud/v2/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/terraform-provider-openstack/utils/v2/auth"
+)
+
+func TestUnitLBAmphoraV2Configure(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/octavia/amphorae/amphora_1/config", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	client := thclient.ServiceClient(fakeServer)
+
+	require.NoError(t, lbAmphoraV2Configure(t.Context(), client, "amphora_1").ExtractErr())
+
+	err := lbAmphoraV2Configure(t.Context(), client, "amphora_2").ExtractErr()
+	assert.True(t, gophercloud.ResponseCodeIs(err, http.StatusNotFound))
+}
+
+func TestUnitLBAmphoraActionV2CreateFailover(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	var (
+		failedOver atomic.Bool
+		gets       atomic.Int32
+	)
+
+	fakeServer.Mux.HandleFunc("/v2.0/octavia/amphorae/amphora_1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"amphora": {"id": "amphora_1", "loadbalancer_id": "lb_1"}}`)
+	})
+
+	fakeServer.Mux.HandleFunc("/v2.0/octavia/amphorae/amphora_1/failover", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+
+		failedOver.Store(true)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	fakeServer.Mux.HandleFunc("/v2.0/lbaas/loadbalancers/lb_1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		// The load-balancer is still ACTIVE right after the failover was
+		// accepted.
+		updatedAt := "2024-01-01T00:00:00"
+		if failedOver.Load() && gets.Add(1) > 1 {
+			updatedAt = "2024-01-01T00:10:00"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"loadbalancer": {"id": "lb_1", "provisioning_status": "ACTIVE", "updated_at": "%s"}}`, updatedAt)
+	})
+
+	config := &Config{
+		Config: auth.Config{
+			OsClient: &gophercloud.ProviderClient{
+				EndpointLocator: func(gophercloud.EndpointOpts) (string, error) {
+					return fakeServer.Endpoint(), nil
+				},
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceLoadBalancerAmphoraActionV2().Schema, map[string]any{
+		"amphora_id": "amphora_1",
+		"action":     lbAmphoraV2ActionFailover,
+	})
+
+	diags := resourceLoadBalancerAmphoraActionV2Create(t.Context(), d, config)
+	require.Empty(t, diags)
+	assert.True(t, failedOver.Load())
+	assert.Equal(t, int32(2), gets.Load())
+	assert.Equal(t, "amphora_1", d.Id())
+	assert.Equal(t, "lb_1", d.Get("loadbalancer_id"))
+}
+
+func TestUnitFlattenLBAmphoraeV2(t *testing.T) {
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	actual := flattenLBAmphoraeV2([]amphorae.Amphora{
+		{
+			ID:             "amphora_1",
+			LoadbalancerID: "lb_1",
+			ComputeID:      "server_1",
+			LBNetworkIP:    "192.168.0.6",
+			Role:           "MASTER",
+			Status:         "ALLOCATED",
+			ImageID:        "image_1",
+			CreatedAt:      createdAt,
+		},
+	})
+
+	require.Len(t, actual, 1)
+	assert.Equal(t, "amphora_1", actual[0]["id"])
+	assert.Equal(t, "lb_1", actual[0]["loadbalancer_id"])
+	assert.Equal(t, "server_1", actual[0]["compute_id"])
+	assert.Equal(t, "192.168.0.6", actual[0]["lb_network_ip"])
+	assert.Equal(t, "MASTER", actual[0]["role"])
+	assert.Equal(t, "ALLOCATED", actual[0]["status"])
+	assert.Equal(t, "image_1", actual[0]["image_id"])
+	assert.Equal(t, "2025-01-02T03:04:05Z", actual[0]["created_at"])
+}
@@ -0,0 +1,306 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// lbAvailabilityZoneProfileV2 represents an Octavia availability zone
+// profile. Gophercloud doesn't support the availability zone profiles.
+type lbAvailabilityZoneProfileV2 struct {
+	ID                   string `json:"id"`
+	Name                 string `json:"name"`
+	ProviderName         string `json:"provider_name"`
+	AvailabilityZoneData string `json:"availability_zone_data"`
+}
+
+// lbAvailabilityZoneProfileV2CreateOpts represents the attributes used when
+// creating an availability zone profile.
+type lbAvailabilityZoneProfileV2CreateOpts struct {
+	Name                 string `json:"name" required:"true"`
+	ProviderName         string `json:"provider_name" required:"true"`
+	AvailabilityZoneData string `json:"availability_zone_data" required:"true"`
+}
+
+// lbAvailabilityZoneProfileV2UpdateOpts represents the attributes used when
+// updating an availability zone profile.
+type lbAvailabilityZoneProfileV2UpdateOpts struct {
+	Name                 *string `json:"name,omitempty"`
+	ProviderName         *string `json:"provider_name,omitempty"`
+	AvailabilityZoneData *string `json:"availability_zone_data,omitempty"`
+}
+
+// lbAvailabilityZoneProfileV2ListOpts represents the filters used when
+// listing availability zone profiles.
+type lbAvailabilityZoneProfileV2ListOpts struct {
+	Name         string `q:"name"`
+	ProviderName string `q:"provider_name"`
+}
+
+type lbAvailabilityZoneProfileV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets the response as an availability zone profile.
+func (r lbAvailabilityZoneProfileV2Result) Extract() (*lbAvailabilityZoneProfileV2, error) {
+	var s struct {
+		AvailabilityZoneProfile *lbAvailabilityZoneProfileV2 `json:"availability_zone_profile"`
+	}
+
+	err := r.ExtractInto(&s)
+
+	return s.AvailabilityZoneProfile, err
+}
+
+type lbAvailabilityZoneProfileV2Page struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL extracts the URL of the next page of availability zone
+// profiles.
+func (r lbAvailabilityZoneProfileV2Page) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"availability_zone_profiles_links"`
+	}
+
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty determines whether a page of availability zone profiles is empty.
+func (r lbAvailabilityZoneProfileV2Page) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	profiles, err := extractLBAvailabilityZoneProfilesV2(r)
+
+	return len(profiles) == 0, err
+}
+
+func extractLBAvailabilityZoneProfilesV2(r pagination.Page) ([]lbAvailabilityZoneProfileV2, error) {
+	var s struct {
+		AvailabilityZoneProfiles []lbAvailabilityZoneProfileV2 `json:"availability_zone_profiles"`
+	}
+
+	err := (r.(lbAvailabilityZoneProfileV2Page)).ExtractInto(&s)
+
+	return s.AvailabilityZoneProfiles, err
+}
+
+func lbAvailabilityZoneProfileV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts lbAvailabilityZoneProfileV2CreateOpts) (r lbAvailabilityZoneProfileV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "availability_zone_profile")
+	if err != nil {
+		r.Err = err
+
+		return
+	}
+
+	resp, err := client.Post(ctx, client.ServiceURL("lbaas", "availabilityzoneprofiles"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func lbAvailabilityZoneProfileV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (r lbAvailabilityZoneProfileV2Result) {
+	resp, err := client.Get(ctx, client.ServiceURL("lbaas", "availabilityzoneprofiles", id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func lbAvailabilityZoneProfileV2Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts lbAvailabilityZoneProfileV2UpdateOpts) (r lbAvailabilityZoneProfileV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "availability_zone_profile")
+	if err != nil {
+		r.Err = err
+
+		return
+	}
+
+	resp, err := client.Put(ctx, client.ServiceURL("lbaas", "availabilityzoneprofiles", id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func lbAvailabilityZoneProfileV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(ctx, client.ServiceURL("lbaas", "availabilityzoneprofiles", id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func lbAvailabilityZoneProfileV2List(client *gophercloud.ServiceClient, opts lbAvailabilityZoneProfileV2ListOpts) pagination.Pager {
+	url := client.ServiceURL("lbaas", "availabilityzoneprofiles")
+
+	query, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+
+	url += query.String()
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return lbAvailabilityZoneProfileV2Page{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// lbAvailabilityZoneV2 represents an Octavia availability zone. The name is
+// the identifier of an availability zone. Gophercloud doesn't support the
+// availability zones.
+type lbAvailabilityZoneV2 struct {
+	Name                      string `json:"name"`
+	Description               string `json:"description"`
+	Enabled                   bool   `json:"enabled"`
+	AvailabilityZoneProfileID string `json:"availability_zone_profile_id"`
+}
+
+// lbAvailabilityZoneV2CreateOpts represents the attributes used when
+// creating an availability zone.
+type lbAvailabilityZoneV2CreateOpts struct {
+	Name                      string `json:"name" required:"true"`
+	Description               string `json:"description,omitempty"`
+	Enabled                   *bool  `json:"enabled,omitempty"`
+	AvailabilityZoneProfileID string `json:"availability_zone_profile_id" required:"true"`
+}
+
+// lbAvailabilityZoneV2UpdateOpts represents the attributes used when
+// updating an availability zone.
+type lbAvailabilityZoneV2UpdateOpts struct {
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+// lbAvailabilityZoneV2ListOpts represents the filters used when listing
+// availability zones.
+type lbAvailabilityZoneV2ListOpts struct {
+	Name                      string `q:"name"`
+	Description               string `q:"description"`
+	Enabled                   *bool  `q:"enabled"`
+	AvailabilityZoneProfileID string `q:"availability_zone_profile_id"`
+}
+
+type lbAvailabilityZoneV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets the response as an availability zone.
+func (r lbAvailabilityZoneV2Result) Extract() (*lbAvailabilityZoneV2, error) {
+	var s struct {
+		AvailabilityZone *lbAvailabilityZoneV2 `json:"availability_zone"`
+	}
+
+	err := r.ExtractInto(&s)
+
+	return s.AvailabilityZone, err
+}
+
+type lbAvailabilityZoneV2Page struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL extracts the URL of the next page of availability zones.
+func (r lbAvailabilityZoneV2Page) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"availability_zones_links"`
+	}
+
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty determines whether a page of availability zones is empty.
+func (r lbAvailabilityZoneV2Page) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	availabilityZones, err := extractLBAvailabilityZonesV2(r)
+
+	return len(availabilityZones) == 0, err
+}
+
+func extractLBAvailabilityZonesV2(r pagination.Page) ([]lbAvailabilityZoneV2, error) {
+	var s struct {
+		AvailabilityZones []lbAvailabilityZoneV2 `json:"availability_zones"`
+	}
+
+	err := (r.(lbAvailabilityZoneV2Page)).ExtractInto(&s)
+
+	return s.AvailabilityZones, err
+}
+
+func lbAvailabilityZoneV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts lbAvailabilityZoneV2CreateOpts) (r lbAvailabilityZoneV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "availability_zone")
+	if err != nil {
+		r.Err = err
+
+		return
+	}
+
+	resp, err := client.Post(ctx, client.ServiceURL("lbaas", "availabilityzones"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func lbAvailabilityZoneV2Get(ctx context.Context, client *gophercloud.ServiceClient, name string) (r lbAvailabilityZoneV2Result) {
+	resp, err := client.Get(ctx, client.ServiceURL("lbaas", "availabilityzones", name), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func lbAvailabilityZoneV2Update(ctx context.Context, client *gophercloud.ServiceClient, name string, opts lbAvailabilityZoneV2UpdateOpts) (r lbAvailabilityZoneV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "availability_zone")
+	if err != nil {
+		r.Err = err
+
+		return
+	}
+
+	resp, err := client.Put(ctx, client.ServiceURL("lbaas", "availabilityzones", name), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func lbAvailabilityZoneV2Delete(ctx context.Context, client *gophercloud.ServiceClient, name string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(ctx, client.ServiceURL("lbaas", "availabilityzones", name), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func lbAvailabilityZoneV2List(client *gophercloud.ServiceClient, opts lbAvailabilityZoneV2ListOpts) pagination.Pager {
+	url := client.ServiceURL("lbaas", "availabilityzones")
+
+	query, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+
+	url += query.String()
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return lbAvailabilityZoneV2Page{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
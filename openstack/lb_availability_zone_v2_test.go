@@ -0,0 +1,92 @@
+package openstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	thclient "github.com/gophercloud/gophercloud/v2/testhelper/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitLBAvailabilityZoneProfileV2List(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/lbaas/availabilityzoneprofiles", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "amphora", r.URL.Query().Get("provider_name"))
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+  "availability_zone_profiles": [
+    {
+      "id": "profile_1",
+      "name": "az-1",
+      "provider_name": "amphora",
+      "availability_zone_data": "{\"compute_zone\": \"az-1\"}"
+    }
+  ],
+  "availability_zone_profiles_links": []
+}`)
+	})
+
+	client := thclient.ServiceClient(fakeServer)
+
+	allPages, err := lbAvailabilityZoneProfileV2List(client, lbAvailabilityZoneProfileV2ListOpts{ProviderName: "amphora"}).AllPages(t.Context())
+	require.NoError(t, err)
+
+	actual, err := extractLBAvailabilityZoneProfilesV2(allPages)
+	require.NoError(t, err)
+
+	expected := []lbAvailabilityZoneProfileV2{
+		{
+			ID:                   "profile_1",
+			Name:                 "az-1",
+			ProviderName:         "amphora",
+			AvailabilityZoneData: `{"compute_zone": "az-1"}`,
+		},
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitLBAvailabilityZoneV2Update(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/lbaas/availabilityzones/az-1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+
+		var body map[string]map[string]any
+
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{"enabled": false}, body["availability_zone"])
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+  "availability_zone": {
+    "name": "az-1",
+    "description": "Availability zone 1",
+    "enabled": false,
+    "availability_zone_profile_id": "profile_1"
+  }
+}`)
+	})
+
+	client := thclient.ServiceClient(fakeServer)
+
+	enabled := false
+
+	actual, err := lbAvailabilityZoneV2Update(t.Context(), client, "az-1", lbAvailabilityZoneV2UpdateOpts{Enabled: &enabled}).Extract()
+	require.NoError(t, err)
+
+	expected := &lbAvailabilityZoneV2{
+		Name:                      "az-1",
+		Description:               "Availability zone 1",
+		Enabled:                   false,
+		AvailabilityZoneProfileID: "profile_1",
+	}
+	assert.Equal(t, expected, actual)
+}
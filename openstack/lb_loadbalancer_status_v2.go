@@ -0,0 +1,73 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/monitors"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+)
+
+func flattenLBStatusListenersV2(listeners []listeners.Listener) []map[string]any {
+	l := make([]map[string]any, len(listeners))
+
+	for i, listener := range listeners {
+		l[i] = map[string]any{
+			"id":                  listener.ID,
+			"name":                listener.Name,
+			"operating_status":    listener.OperatingStatus,
+			"provisioning_status": listener.ProvisioningStatus,
+			"pools":               flattenLBStatusPoolsV2(listener.Pools),
+		}
+	}
+
+	return l
+}
+
+func flattenLBStatusPoolsV2(pools []pools.Pool) []map[string]any {
+	p := make([]map[string]any, len(pools))
+
+	for i, pool := range pools {
+		p[i] = map[string]any{
+			"id":                  pool.ID,
+			"name":                pool.Name,
+			"operating_status":    pool.OperatingStatus,
+			"provisioning_status": pool.ProvisioningStatus,
+			"healthmonitor":       flattenLBStatusMonitorV2(pool.Monitor),
+			"members":             flattenLBStatusMembersV2(pool.Members),
+		}
+	}
+
+	return p
+}
+
+func flattenLBStatusMonitorV2(monitor monitors.Monitor) []map[string]any {
+	if monitor.ID == "" {
+		return nil
+	}
+
+	return []map[string]any{
+		{
+			"id":                  monitor.ID,
+			"name":                monitor.Name,
+			"type":                monitor.Type,
+			"operating_status":    monitor.OperatingStatus,
+			"provisioning_status": monitor.ProvisioningStatus,
+		},
+	}
+}
+
+func flattenLBStatusMembersV2(members []pools.Member) []map[string]any {
+	m := make([]map[string]any, len(members))
+
+	for i, member := range members {
+		m[i] = map[string]any{
+			"id":                  member.ID,
+			"name":                member.Name,
+			"address":             member.Address,
+			"protocol_port":       member.ProtocolPort,
+			"operating_status":    member.OperatingStatus,
+			"provisioning_status": member.ProvisioningStatus,
+		}
+	}
+
+	return m
+}
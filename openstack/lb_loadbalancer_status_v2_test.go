@@ -0,0 +1,108 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	thclient "github.com/gophercloud/gophercloud/v2/testhelper/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitFlattenLBStatusListenersV2(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/lbaas/loadbalancers/lb_1/status", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+  "statuses": {
+    "loadbalancer": {
+      "id": "lb_1",
+      "name": "lb",
+      "operating_status": "ONLINE",
+      "provisioning_status": "ACTIVE",
+      "listeners": [
+        {
+          "id": "listener_1",
+          "name": "listener",
+          "operating_status": "ONLINE",
+          "provisioning_status": "ACTIVE",
+          "pools": [
+            {
+              "id": "pool_1",
+              "name": "pool",
+              "operating_status": "DEGRADED",
+              "provisioning_status": "ACTIVE",
+              "healthmonitor": {
+                "id": "monitor_1",
+                "name": "monitor",
+                "type": "HTTP",
+                "provisioning_status": "ACTIVE"
+              },
+              "members": [
+                {
+                  "id": "member_1",
+                  "name": "member",
+                  "address": "192.168.0.10",
+                  "protocol_port": 8080,
+                  "operating_status": "ERROR",
+                  "provisioning_status": "ACTIVE"
+                }
+              ]
+            }
+          ]
+        }
+      ]
+    }
+  }
+}`)
+	})
+
+	client := thclient.ServiceClient(fakeServer)
+
+	statuses, err := loadbalancers.GetStatuses(t.Context(), client, "lb_1").Extract()
+	require.NoError(t, err)
+	require.NotNil(t, statuses.Loadbalancer)
+
+	expected := []map[string]any{
+		{
+			"id":                  "listener_1",
+			"name":                "listener",
+			"operating_status":    "ONLINE",
+			"provisioning_status": "ACTIVE",
+			"pools": []map[string]any{
+				{
+					"id":                  "pool_1",
+					"name":                "pool",
+					"operating_status":    "DEGRADED",
+					"provisioning_status": "ACTIVE",
+					"healthmonitor": []map[string]any{
+						{
+							"id":                  "monitor_1",
+							"name":                "monitor",
+							"type":                "HTTP",
+							"operating_status":    "",
+							"provisioning_status": "ACTIVE",
+						},
+					},
+					"members": []map[string]any{
+						{
+							"id":                  "member_1",
+							"name":                "member",
+							"address":             "192.168.0.10",
+							"protocol_port":       8080,
+							"operating_status":    "ERROR",
+							"provisioning_status": "ACTIVE",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, expected, flattenLBStatusListenersV2(statuses.Loadbalancer.Listeners))
+}
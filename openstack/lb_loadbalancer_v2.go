@@ -0,0 +1,986 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/monitors"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// lbLoadBalancerV2ListenerSchema returns the schema of the listeners, which
+// are declared inline in openstack_lb_loadbalancer_v2 together with their
+// default pools, members and health monitors.
+func lbLoadBalancerV2ListenerSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+
+				"description": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+
+				"protocol": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"TCP", "UDP", "SCTP", "HTTP", "HTTPS", "TERMINATED_HTTPS", "PROMETHEUS",
+					}, false),
+				},
+
+				"protocol_port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, 65535),
+				},
+
+				"connection_limit": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+
+				"default_tls_container_ref": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+
+				"sni_container_refs": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+
+				"allowed_cidrs": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+
+				"admin_state_up": {
+					Type:     schema.TypeBool,
+					Default:  true,
+					Optional: true,
+				},
+
+				"default_pool": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"id": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+
+							"name": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+
+							"description": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+
+							"protocol": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"TCP", "UDP", "HTTP", "HTTPS", "PROXY", "SCTP", "PROXYV2",
+								}, false),
+							},
+
+							"lb_method": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"ROUND_ROBIN", "LEAST_CONNECTIONS", "SOURCE_IP", "SOURCE_IP_PORT",
+								}, false),
+							},
+
+							"admin_state_up": {
+								Type:     schema.TypeBool,
+								Default:  true,
+								Optional: true,
+							},
+
+							"healthmonitor": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"id": {
+											Type:     schema.TypeString,
+											Computed: true,
+										},
+
+										"name": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+
+										"type": {
+											Type:     schema.TypeString,
+											Required: true,
+											ValidateFunc: validation.StringInSlice([]string{
+												"HTTP", "HTTPS", "PING", "SCTP", "TCP",
+												"TLS-HELLO", "UDP-CONNECT",
+											}, false),
+										},
+
+										"delay": {
+											Type:     schema.TypeInt,
+											Required: true,
+										},
+
+										"timeout": {
+											Type:     schema.TypeInt,
+											Required: true,
+										},
+
+										"max_retries": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntBetween(1, 10),
+										},
+
+										"max_retries_down": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											Computed:     true,
+											ValidateFunc: validation.IntBetween(1, 10),
+										},
+
+										"url_path": {
+											Type:     schema.TypeString,
+											Optional: true,
+											Computed: true,
+										},
+
+										"http_method": {
+											Type:     schema.TypeString,
+											Optional: true,
+											Computed: true,
+											ValidateFunc: validation.StringInSlice([]string{
+												"CONNECT", "DELETE", "GET", "HEAD", "OPTIONS",
+												"PATCH", "POST", "PUT", "TRACE",
+											}, false),
+										},
+
+										"expected_codes": {
+											Type:     schema.TypeString,
+											Optional: true,
+											Computed: true,
+										},
+
+										"admin_state_up": {
+											Type:     schema.TypeBool,
+											Default:  true,
+											Optional: true,
+										},
+									},
+								},
+							},
+
+							"member": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"id": {
+											Type:     schema.TypeString,
+											Computed: true,
+										},
+
+										"name": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+
+										"address": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+
+										"protocol_port": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntBetween(1, 65535),
+										},
+
+										"weight": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											Default:      1,
+											ValidateFunc: validation.IntBetween(0, 256),
+										},
+
+										"monitor_port": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntBetween(1, 65535),
+										},
+
+										"monitor_address": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+
+										"subnet_id": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+
+										"backup": {
+											Type:     schema.TypeBool,
+											Optional: true,
+										},
+
+										"admin_state_up": {
+											Type:     schema.TypeBool,
+											Default:  true,
+											Optional: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// lbLoadBalancerV2ListenerKey identifies an inline listener. The protocol and
+// the port of a listener are unique within a load balancer.
+func lbLoadBalancerV2ListenerKey(protocol string, protocolPort int) string {
+	return fmt.Sprintf("%s/%d", protocol, protocolPort)
+}
+
+// lbLoadBalancerV2MemberKey identifies an inline member. The address and the
+// port of a member are unique within a pool.
+func lbLoadBalancerV2MemberKey(address string, protocolPort int) string {
+	return fmt.Sprintf("%s/%d", address, protocolPort)
+}
+
+func lbLoadBalancerV2Block(v any) map[string]any {
+	l, ok := v.([]any)
+	if !ok || len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	return l[0].(map[string]any)
+}
+
+func expandLBLoadBalancerV2Listeners(raw []any) []listeners.CreateOpts {
+	opts := make([]listeners.CreateOpts, 0, len(raw))
+
+	for _, v := range raw {
+		l := v.(map[string]any)
+		adminStateUp := l["admin_state_up"].(bool)
+
+		listener := listeners.CreateOpts{
+			Name:                   l["name"].(string),
+			Description:            l["description"].(string),
+			Protocol:               listeners.Protocol(l["protocol"].(string)),
+			ProtocolPort:           l["protocol_port"].(int),
+			DefaultTlsContainerRef: l["default_tls_container_ref"].(string),
+			SniContainerRefs:       expandToStringSlice(l["sni_container_refs"].([]any)),
+			AllowedCIDRs:           expandToStringSlice(l["allowed_cidrs"].([]any)),
+			AdminStateUp:           &adminStateUp,
+		}
+
+		if connLimit := l["connection_limit"].(int); connLimit != 0 {
+			listener.ConnLimit = &connLimit
+		}
+
+		if p := lbLoadBalancerV2Block(l["default_pool"]); p != nil {
+			pool := expandLBLoadBalancerV2Pool(p)
+			listener.DefaultPool = &pool
+		}
+
+		opts = append(opts, listener)
+	}
+
+	return opts
+}
+
+func expandLBLoadBalancerV2Pool(p map[string]any) pools.CreateOpts {
+	adminStateUp := p["admin_state_up"].(bool)
+
+	pool := pools.CreateOpts{
+		Name:         p["name"].(string),
+		Description:  p["description"].(string),
+		Protocol:     pools.Protocol(p["protocol"].(string)),
+		LBMethod:     pools.LBMethod(p["lb_method"].(string)),
+		AdminStateUp: &adminStateUp,
+	}
+
+	for _, v := range p["member"].([]any) {
+		m := v.(map[string]any)
+		weight := m["weight"].(int)
+		adminStateUp := m["admin_state_up"].(bool)
+
+		member := pools.CreateMemberOpts{
+			Name:           m["name"].(string),
+			Address:        m["address"].(string),
+			ProtocolPort:   m["protocol_port"].(int),
+			SubnetID:       m["subnet_id"].(string),
+			MonitorAddress: m["monitor_address"].(string),
+			Weight:         &weight,
+			AdminStateUp:   &adminStateUp,
+		}
+
+		if backup := m["backup"].(bool); backup {
+			member.Backup = &backup
+		}
+
+		if monitorPort := m["monitor_port"].(int); monitorPort > 0 {
+			member.MonitorPort = &monitorPort
+		}
+
+		pool.Members = append(pool.Members, member)
+	}
+
+	if hm := lbLoadBalancerV2Block(p["healthmonitor"]); hm != nil {
+		pool.Monitor = expandLBLoadBalancerV2Monitor(hm, "")
+	}
+
+	return pool
+}
+
+func expandLBLoadBalancerV2Monitor(hm map[string]any, poolID string) monitors.CreateOpts {
+	adminStateUp := hm["admin_state_up"].(bool)
+
+	return monitors.CreateOpts{
+		PoolID:         poolID,
+		Name:           hm["name"].(string),
+		Type:           hm["type"].(string),
+		Delay:          hm["delay"].(int),
+		Timeout:        hm["timeout"].(int),
+		MaxRetries:     hm["max_retries"].(int),
+		MaxRetriesDown: hm["max_retries_down"].(int),
+		URLPath:        hm["url_path"].(string),
+		HTTPMethod:     hm["http_method"].(string),
+		ExpectedCodes:  hm["expected_codes"].(string),
+		AdminStateUp:   &adminStateUp,
+	}
+}
+
+// flattenLBLoadBalancerV2Listeners reads the inline listeners of a load
+// balancer. The listeners and the members are kept in the order of the
+// current state, while listeners created outside of the resource are
+// appended, so that they are removed on the next apply.
+func flattenLBLoadBalancerV2Listeners(ctx context.Context, lbClient *gophercloud.ServiceClient, lbID string, current []any) ([]map[string]any, error) {
+	allPages, err := listeners.List(lbClient, listeners.ListOpts{LoadbalancerID: lbID}).AllPages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to list listeners of loadbalancer %s: %w", lbID, err)
+	}
+
+	allListeners, err := listeners.ExtractListeners(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve listeners of loadbalancer %s: %w", lbID, err)
+	}
+
+	byKey := make(map[string]listeners.Listener, len(allListeners))
+	for _, listener := range allListeners {
+		byKey[lbLoadBalancerV2ListenerKey(listener.Protocol, listener.ProtocolPort)] = listener
+	}
+
+	ordered := make([]listeners.Listener, 0, len(allListeners))
+
+	for _, v := range current {
+		l, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		key := lbLoadBalancerV2ListenerKey(l["protocol"].(string), l["protocol_port"].(int))
+		if listener, ok := byKey[key]; ok {
+			ordered = append(ordered, listener)
+			delete(byKey, key)
+		}
+	}
+
+	for _, listener := range allListeners {
+		if _, ok := byKey[lbLoadBalancerV2ListenerKey(listener.Protocol, listener.ProtocolPort)]; ok {
+			ordered = append(ordered, listener)
+		}
+	}
+
+	currentPools := make(map[string]map[string]any, len(current))
+
+	for _, v := range current {
+		l, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		currentPools[lbLoadBalancerV2ListenerKey(l["protocol"].(string), l["protocol_port"].(int))] = lbLoadBalancerV2Block(l["default_pool"])
+	}
+
+	result := make([]map[string]any, len(ordered))
+
+	for i, listener := range ordered {
+		var defaultPool []map[string]any
+
+		if listener.DefaultPoolID != "" {
+			key := lbLoadBalancerV2ListenerKey(listener.Protocol, listener.ProtocolPort)
+
+			pool, err := flattenLBLoadBalancerV2Pool(ctx, lbClient, listener.DefaultPoolID, currentPools[key])
+			if err != nil {
+				return nil, err
+			}
+
+			defaultPool = []map[string]any{pool}
+		}
+
+		result[i] = map[string]any{
+			"id":                        listener.ID,
+			"name":                      listener.Name,
+			"description":               listener.Description,
+			"protocol":                  listener.Protocol,
+			"protocol_port":             listener.ProtocolPort,
+			"connection_limit":          listener.ConnLimit,
+			"default_tls_container_ref": listener.DefaultTlsContainerRef,
+			"sni_container_refs":        listener.SniContainerRefs,
+			"allowed_cidrs":             listener.AllowedCIDRs,
+			"admin_state_up":            listener.AdminStateUp,
+			"default_pool":              defaultPool,
+		}
+	}
+
+	return result, nil
+}
+
+func flattenLBLoadBalancerV2Pool(ctx context.Context, lbClient *gophercloud.ServiceClient, poolID string, current map[string]any) (map[string]any, error) {
+	pool, err := pools.Get(ctx, lbClient, poolID).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve pool %s: %w", poolID, err)
+	}
+
+	allPages, err := pools.ListMembers(lbClient, poolID, pools.ListMembersOpts{}).AllPages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to list members of pool %s: %w", poolID, err)
+	}
+
+	allMembers, err := pools.ExtractMembers(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve members of pool %s: %w", poolID, err)
+	}
+
+	var currentMembers []any
+	if current != nil {
+		currentMembers, _ = current["member"].([]any)
+	}
+
+	var healthmonitor []map[string]any
+
+	if pool.MonitorID != "" {
+		monitor, err := monitors.Get(ctx, lbClient, pool.MonitorID).Extract()
+		if err != nil {
+			return nil, fmt.Errorf("Unable to retrieve health monitor %s: %w", pool.MonitorID, err)
+		}
+
+		healthmonitor = []map[string]any{
+			{
+				"id":               monitor.ID,
+				"name":             monitor.Name,
+				"type":             monitor.Type,
+				"delay":            monitor.Delay,
+				"timeout":          monitor.Timeout,
+				"max_retries":      monitor.MaxRetries,
+				"max_retries_down": monitor.MaxRetriesDown,
+				"url_path":         monitor.URLPath,
+				"http_method":      monitor.HTTPMethod,
+				"expected_codes":   monitor.ExpectedCodes,
+				"admin_state_up":   monitor.AdminStateUp,
+			},
+		}
+	}
+
+	return map[string]any{
+		"id":             pool.ID,
+		"name":           pool.Name,
+		"description":    pool.Description,
+		"protocol":       pool.Protocol,
+		"lb_method":      pool.LBMethod,
+		"admin_state_up": pool.AdminStateUp,
+		"healthmonitor":  healthmonitor,
+		"member":         flattenLBMembersV2(orderLBLoadBalancerV2Members(allMembers, currentMembers)),
+	}, nil
+}
+
+func orderLBLoadBalancerV2Members(members []pools.Member, current []any) []pools.Member {
+	byKey := make(map[string]pools.Member, len(members))
+	for _, member := range members {
+		byKey[lbLoadBalancerV2MemberKey(member.Address, member.ProtocolPort)] = member
+	}
+
+	ordered := make([]pools.Member, 0, len(members))
+
+	for _, v := range current {
+		m, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		key := lbLoadBalancerV2MemberKey(m["address"].(string), m["protocol_port"].(int))
+		if member, ok := byKey[key]; ok {
+			ordered = append(ordered, member)
+			delete(byKey, key)
+		}
+	}
+
+	for _, member := range members {
+		if _, ok := byKey[lbLoadBalancerV2MemberKey(member.Address, member.ProtocolPort)]; ok {
+			ordered = append(ordered, member)
+		}
+	}
+
+	return ordered
+}
+
+// lbLoadBalancerV2Apply runs a change of an inline child object. Octavia
+// rejects changes while the load balancer is not ACTIVE, so it waits before
+// and after the change.
+func lbLoadBalancerV2Apply(ctx context.Context, lbClient *gophercloud.ServiceClient, lbID string, timeout time.Duration, f func() error) error {
+	err := waitForLBV2LoadBalancer(ctx, lbClient, lbID, "ACTIVE", getLbPendingStatuses(), timeout)
+	if err != nil {
+		return err
+	}
+
+	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		if err := f(); err != nil {
+			return checkForRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return waitForLBV2LoadBalancer(ctx, lbClient, lbID, "ACTIVE", getLbPendingStatuses(), timeout)
+}
+
+// updateLBLoadBalancerV2Listeners applies the changes of the inline listeners.
+// The listeners are matched by their protocol and port, so only the changed
+// listeners, pools, health monitors and members are updated.
+func updateLBLoadBalancerV2Listeners(ctx context.Context, lbClient *gophercloud.ServiceClient, lbID string, oldRaw, newRaw []any, timeout time.Duration) error {
+	oldListeners := make(map[string]map[string]any, len(oldRaw))
+
+	for _, v := range oldRaw {
+		l := v.(map[string]any)
+		oldListeners[lbLoadBalancerV2ListenerKey(l["protocol"].(string), l["protocol_port"].(int))] = l
+	}
+
+	newListeners := make(map[string]map[string]any, len(newRaw))
+
+	for _, v := range newRaw {
+		l := v.(map[string]any)
+		newListeners[lbLoadBalancerV2ListenerKey(l["protocol"].(string), l["protocol_port"].(int))] = l
+	}
+
+	for key, o := range oldListeners {
+		if _, ok := newListeners[key]; ok {
+			continue
+		}
+
+		if err := deleteLBLoadBalancerV2Listener(ctx, lbClient, lbID, o, timeout); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range newRaw {
+		n := v.(map[string]any)
+		key := lbLoadBalancerV2ListenerKey(n["protocol"].(string), n["protocol_port"].(int))
+
+		o, ok := oldListeners[key]
+		if !ok {
+			createOpts := expandLBLoadBalancerV2Listeners([]any{n})[0]
+			createOpts.LoadbalancerID = lbID
+
+			log.Printf("[DEBUG] Creating listener %s of openstack_lb_loadbalancer_v2 %s", key, lbID)
+
+			err := lbLoadBalancerV2Apply(ctx, lbClient, lbID, timeout, func() error {
+				_, err := listeners.Create(ctx, lbClient, createOpts).Extract()
+
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("Error creating listener %s: %w", key, err)
+			}
+
+			continue
+		}
+
+		if err := updateLBLoadBalancerV2Listener(ctx, lbClient, lbID, o, n, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deleteLBLoadBalancerV2Listener(ctx context.Context, lbClient *gophercloud.ServiceClient, lbID string, l map[string]any, timeout time.Duration) error {
+	id := l["id"].(string)
+
+	// The default pool isn't deleted together with the listener.
+	if p := lbLoadBalancerV2Block(l["default_pool"]); p != nil {
+		if err := deleteLBLoadBalancerV2Pool(ctx, lbClient, lbID, p, timeout); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting listener %s of openstack_lb_loadbalancer_v2 %s", id, lbID)
+
+	err := lbLoadBalancerV2Apply(ctx, lbClient, lbID, timeout, func() error {
+		return listeners.Delete(ctx, lbClient, id).ExtractErr()
+	})
+	if err != nil && !gophercloud.ResponseCodeIs(err, 404) {
+		return fmt.Errorf("Error deleting listener %s: %w", id, err)
+	}
+
+	return nil
+}
+
+func deleteLBLoadBalancerV2Pool(ctx context.Context, lbClient *gophercloud.ServiceClient, lbID string, p map[string]any, timeout time.Duration) error {
+	id := p["id"].(string)
+
+	log.Printf("[DEBUG] Deleting pool %s of openstack_lb_loadbalancer_v2 %s", id, lbID)
+
+	// Deleting a pool also deletes its members and its health monitor.
+	err := lbLoadBalancerV2Apply(ctx, lbClient, lbID, timeout, func() error {
+		return pools.Delete(ctx, lbClient, id).ExtractErr()
+	})
+	if err != nil && !gophercloud.ResponseCodeIs(err, 404) {
+		return fmt.Errorf("Error deleting pool %s: %w", id, err)
+	}
+
+	return nil
+}
+
+func updateLBLoadBalancerV2Listener(ctx context.Context, lbClient *gophercloud.ServiceClient, lbID string, o, n map[string]any, timeout time.Duration) error {
+	id := o["id"].(string)
+
+	var (
+		hasChange  bool
+		updateOpts listeners.UpdateOpts
+	)
+
+	if o["name"] != n["name"] {
+		hasChange = true
+		name := n["name"].(string)
+		updateOpts.Name = &name
+	}
+
+	if o["description"] != n["description"] {
+		hasChange = true
+		description := n["description"].(string)
+		updateOpts.Description = &description
+	}
+
+	if o["connection_limit"] != n["connection_limit"] {
+		hasChange = true
+		connLimit := n["connection_limit"].(int)
+		updateOpts.ConnLimit = &connLimit
+	}
+
+	if o["default_tls_container_ref"] != n["default_tls_container_ref"] {
+		hasChange = true
+		defaultTLSContainerRef := n["default_tls_container_ref"].(string)
+		updateOpts.DefaultTlsContainerRef = &defaultTLSContainerRef
+	}
+
+	if !slices.Equal(expandToStringSlice(o["sni_container_refs"].([]any)), expandToStringSlice(n["sni_container_refs"].([]any))) {
+		hasChange = true
+		sniContainerRefs := expandToStringSlice(n["sni_container_refs"].([]any))
+		updateOpts.SniContainerRefs = &sniContainerRefs
+	}
+
+	if !slices.Equal(expandToStringSlice(o["allowed_cidrs"].([]any)), expandToStringSlice(n["allowed_cidrs"].([]any))) {
+		hasChange = true
+		allowedCIDRs := expandToStringSlice(n["allowed_cidrs"].([]any))
+		updateOpts.AllowedCIDRs = &allowedCIDRs
+	}
+
+	if o["admin_state_up"] != n["admin_state_up"] {
+		hasChange = true
+		adminStateUp := n["admin_state_up"].(bool)
+		updateOpts.AdminStateUp = &adminStateUp
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] Updating listener %s of openstack_lb_loadbalancer_v2 %s with options: %#v", id, lbID, updateOpts)
+
+		err := lbLoadBalancerV2Apply(ctx, lbClient, lbID, timeout, func() error {
+			_, err := listeners.Update(ctx, lbClient, id, updateOpts).Extract()
+
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating listener %s: %w", id, err)
+		}
+	}
+
+	oldPool := lbLoadBalancerV2Block(o["default_pool"])
+	newPool := lbLoadBalancerV2Block(n["default_pool"])
+
+	// The protocol of a pool can't be changed, so the pool is replaced.
+	if oldPool != nil && (newPool == nil || oldPool["protocol"] != newPool["protocol"]) {
+		if err := deleteLBLoadBalancerV2Pool(ctx, lbClient, lbID, oldPool, timeout); err != nil {
+			return err
+		}
+
+		oldPool = nil
+	}
+
+	if newPool == nil {
+		return nil
+	}
+
+	if oldPool == nil {
+		createOpts := expandLBLoadBalancerV2Pool(newPool)
+		createOpts.ListenerID = id
+
+		log.Printf("[DEBUG] Creating default pool of listener %s of openstack_lb_loadbalancer_v2 %s", id, lbID)
+
+		err := lbLoadBalancerV2Apply(ctx, lbClient, lbID, timeout, func() error {
+			_, err := pools.Create(ctx, lbClient, createOpts).Extract()
+
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating default pool of listener %s: %w", id, err)
+		}
+
+		return nil
+	}
+
+	return updateLBLoadBalancerV2Pool(ctx, lbClient, lbID, oldPool, newPool, timeout)
+}
+
+func updateLBLoadBalancerV2Pool(ctx context.Context, lbClient *gophercloud.ServiceClient, lbID string, o, n map[string]any, timeout time.Duration) error {
+	id := o["id"].(string)
+
+	var (
+		hasChange  bool
+		updateOpts pools.UpdateOpts
+	)
+
+	if o["name"] != n["name"] {
+		hasChange = true
+		name := n["name"].(string)
+		updateOpts.Name = &name
+	}
+
+	if o["description"] != n["description"] {
+		hasChange = true
+		description := n["description"].(string)
+		updateOpts.Description = &description
+	}
+
+	if o["lb_method"] != n["lb_method"] {
+		hasChange = true
+		updateOpts.LBMethod = pools.LBMethod(n["lb_method"].(string))
+	}
+
+	if o["admin_state_up"] != n["admin_state_up"] {
+		hasChange = true
+		adminStateUp := n["admin_state_up"].(bool)
+		updateOpts.AdminStateUp = &adminStateUp
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] Updating pool %s of openstack_lb_loadbalancer_v2 %s with options: %#v", id, lbID, updateOpts)
+
+		err := lbLoadBalancerV2Apply(ctx, lbClient, lbID, timeout, func() error {
+			_, err := pools.Update(ctx, lbClient, id, updateOpts).Extract()
+
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating pool %s: %w", id, err)
+		}
+	}
+
+	if err := updateLBLoadBalancerV2Monitor(ctx, lbClient, lbID, id, lbLoadBalancerV2Block(o["healthmonitor"]), lbLoadBalancerV2Block(n["healthmonitor"]), timeout); err != nil {
+		return err
+	}
+
+	if !lbLoadBalancerV2MembersEqual(o["member"].([]any), n["member"].([]any)) {
+		members := expandLBMembersV2(n["member"].([]any))
+
+		log.Printf("[DEBUG] Updating members of pool %s of openstack_lb_loadbalancer_v2 %s: %#v", id, lbID, members)
+
+		err := lbLoadBalancerV2Apply(ctx, lbClient, lbID, timeout, func() error {
+			return pools.BatchUpdateMembers(ctx, lbClient, id, members).ExtractErr()
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating members of pool %s: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+func updateLBLoadBalancerV2Monitor(ctx context.Context, lbClient *gophercloud.ServiceClient, lbID, poolID string, o, n map[string]any, timeout time.Duration) error {
+	// The type of a health monitor can't be changed, so the health monitor
+	// is replaced.
+	if o != nil && (n == nil || o["type"] != n["type"]) {
+		id := o["id"].(string)
+
+		log.Printf("[DEBUG] Deleting health monitor %s of openstack_lb_loadbalancer_v2 %s", id, lbID)
+
+		err := lbLoadBalancerV2Apply(ctx, lbClient, lbID, timeout, func() error {
+			return monitors.Delete(ctx, lbClient, id).ExtractErr()
+		})
+		if err != nil && !gophercloud.ResponseCodeIs(err, 404) {
+			return fmt.Errorf("Error deleting health monitor %s: %w", id, err)
+		}
+
+		o = nil
+	}
+
+	if n == nil {
+		return nil
+	}
+
+	if o == nil {
+		createOpts := expandLBLoadBalancerV2Monitor(n, poolID)
+
+		log.Printf("[DEBUG] Creating health monitor of pool %s of openstack_lb_loadbalancer_v2 %s", poolID, lbID)
+
+		err := lbLoadBalancerV2Apply(ctx, lbClient, lbID, timeout, func() error {
+			_, err := monitors.Create(ctx, lbClient, createOpts).Extract()
+
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating health monitor of pool %s: %w", poolID, err)
+		}
+
+		return nil
+	}
+
+	id := o["id"].(string)
+
+	var (
+		hasChange  bool
+		updateOpts monitors.UpdateOpts
+	)
+
+	if o["name"] != n["name"] {
+		hasChange = true
+		name := n["name"].(string)
+		updateOpts.Name = &name
+	}
+
+	if o["delay"] != n["delay"] {
+		hasChange = true
+		updateOpts.Delay = n["delay"].(int)
+	}
+
+	if o["timeout"] != n["timeout"] {
+		hasChange = true
+		updateOpts.Timeout = n["timeout"].(int)
+	}
+
+	if o["max_retries"] != n["max_retries"] {
+		hasChange = true
+		updateOpts.MaxRetries = n["max_retries"].(int)
+	}
+
+	if o["max_retries_down"] != n["max_retries_down"] {
+		hasChange = true
+		updateOpts.MaxRetriesDown = n["max_retries_down"].(int)
+	}
+
+	if o["url_path"] != n["url_path"] {
+		hasChange = true
+		updateOpts.URLPath = n["url_path"].(string)
+	}
+
+	if o["http_method"] != n["http_method"] {
+		hasChange = true
+		updateOpts.HTTPMethod = n["http_method"].(string)
+	}
+
+	if o["expected_codes"] != n["expected_codes"] {
+		hasChange = true
+		updateOpts.ExpectedCodes = n["expected_codes"].(string)
+	}
+
+	if o["admin_state_up"] != n["admin_state_up"] {
+		hasChange = true
+		adminStateUp := n["admin_state_up"].(bool)
+		updateOpts.AdminStateUp = &adminStateUp
+	}
+
+	if !hasChange {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Updating health monitor %s of openstack_lb_loadbalancer_v2 %s with options: %#v", id, lbID, updateOpts)
+
+	err := lbLoadBalancerV2Apply(ctx, lbClient, lbID, timeout, func() error {
+		_, err := monitors.Update(ctx, lbClient, id, updateOpts).Extract()
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating health monitor %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// lbLoadBalancerV2MembersEqual compares the configured attributes of the
+// members, ignoring the computed IDs.
+func lbLoadBalancerV2MembersEqual(o, n []any) bool {
+	if len(o) != len(n) {
+		return false
+	}
+
+	for i := range o {
+		om := o[i].(map[string]any)
+		nm := n[i].(map[string]any)
+
+		for k, v := range nm {
+			if k == "id" {
+				continue
+			}
+
+			if om[k] != v {
+				return false
+			}
+		}
+	}
+
+	return true
+}
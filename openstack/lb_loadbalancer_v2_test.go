@@ -0,0 +1,164 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/monitors"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+	"github.com/stretchr/testify/assert"
+)
+
+func testLBLoadBalancerV2Member(address string, protocolPort int) map[string]any {
+	return map[string]any{
+		"id":              "",
+		"name":            "",
+		"address":         address,
+		"protocol_port":   protocolPort,
+		"weight":          1,
+		"monitor_port":    0,
+		"monitor_address": "",
+		"subnet_id":       "",
+		"backup":          false,
+		"admin_state_up":  true,
+	}
+}
+
+func TestUnitExpandLBLoadBalancerV2Listeners(t *testing.T) {
+	raw := []any{
+		map[string]any{
+			"name":                      "listener_1",
+			"description":               "",
+			"protocol":                  "HTTP",
+			"protocol_port":             8080,
+			"connection_limit":          0,
+			"default_tls_container_ref": "",
+			"sni_container_refs":        []any{},
+			"allowed_cidrs":             []any{"10.0.0.0/8"},
+			"admin_state_up":            true,
+			"default_pool": []any{
+				map[string]any{
+					"name":           "pool_1",
+					"description":    "",
+					"protocol":       "HTTP",
+					"lb_method":      "ROUND_ROBIN",
+					"admin_state_up": true,
+					"healthmonitor": []any{
+						map[string]any{
+							"name":             "",
+							"type":             "HTTP",
+							"delay":            20,
+							"timeout":          10,
+							"max_retries":      5,
+							"max_retries_down": 0,
+							"url_path":         "/health",
+							"http_method":      "",
+							"expected_codes":   "",
+							"admin_state_up":   true,
+						},
+					},
+					"member": []any{
+						testLBLoadBalancerV2Member("192.168.199.10", 8080),
+					},
+				},
+			},
+		},
+		map[string]any{
+			"name":                      "",
+			"description":               "",
+			"protocol":                  "TCP",
+			"protocol_port":             22,
+			"connection_limit":          5,
+			"default_tls_container_ref": "",
+			"sni_container_refs":        []any{},
+			"allowed_cidrs":             []any{},
+			"admin_state_up":            false,
+			"default_pool":              []any{},
+		},
+	}
+
+	adminStateUp := true
+	adminStateDown := false
+	weight := 1
+	connLimit := 5
+
+	expected := []listeners.CreateOpts{
+		{
+			Name:             "listener_1",
+			Protocol:         listeners.ProtocolHTTP,
+			ProtocolPort:     8080,
+			SniContainerRefs: []string{},
+			AllowedCIDRs:     []string{"10.0.0.0/8"},
+			AdminStateUp:     &adminStateUp,
+			DefaultPool: &pools.CreateOpts{
+				Name:         "pool_1",
+				Protocol:     pools.ProtocolHTTP,
+				LBMethod:     pools.LBMethodRoundRobin,
+				AdminStateUp: &adminStateUp,
+				Members: []pools.CreateMemberOpts{
+					{
+						Address:      "192.168.199.10",
+						ProtocolPort: 8080,
+						Weight:       &weight,
+						AdminStateUp: &adminStateUp,
+					},
+				},
+				Monitor: monitors.CreateOpts{
+					Type:         "HTTP",
+					Delay:        20,
+					Timeout:      10,
+					MaxRetries:   5,
+					URLPath:      "/health",
+					AdminStateUp: &adminStateUp,
+				},
+			},
+		},
+		{
+			Protocol:         listeners.ProtocolTCP,
+			ProtocolPort:     22,
+			ConnLimit:        &connLimit,
+			SniContainerRefs: []string{},
+			AllowedCIDRs:     []string{},
+			AdminStateUp:     &adminStateDown,
+		},
+	}
+
+	assert.Equal(t, expected, expandLBLoadBalancerV2Listeners(raw))
+}
+
+func TestUnitOrderLBLoadBalancerV2Members(t *testing.T) {
+	members := []pools.Member{
+		{ID: "member_1", Address: "192.168.199.10", ProtocolPort: 8080},
+		{ID: "member_2", Address: "192.168.199.11", ProtocolPort: 8080},
+		{ID: "member_3", Address: "192.168.199.12", ProtocolPort: 8080},
+	}
+
+	current := []any{
+		testLBLoadBalancerV2Member("192.168.199.12", 8080),
+		testLBLoadBalancerV2Member("192.168.199.20", 8080),
+		testLBLoadBalancerV2Member("192.168.199.10", 8080),
+	}
+
+	actual := orderLBLoadBalancerV2Members(members, current)
+
+	ids := make([]string, len(actual))
+	for i, member := range actual {
+		ids[i] = member.ID
+	}
+
+	assert.Equal(t, []string{"member_3", "member_1", "member_2"}, ids)
+}
+
+func TestUnitLBLoadBalancerV2MembersEqual(t *testing.T) {
+	o := []any{testLBLoadBalancerV2Member("192.168.199.10", 8080)}
+	o[0].(map[string]any)["id"] = "member_1"
+
+	n := []any{testLBLoadBalancerV2Member("192.168.199.10", 8080)}
+	assert.True(t, lbLoadBalancerV2MembersEqual(o, n))
+
+	n[0].(map[string]any)["weight"] = 10
+	assert.False(t, lbLoadBalancerV2MembersEqual(o, n))
+
+	n = append(n, testLBLoadBalancerV2Member("192.168.199.11", 8080))
+	assert.False(t, lbLoadBalancerV2MembersEqual(o, n))
+}
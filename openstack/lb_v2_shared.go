@@ -15,6 +15,7 @@ import (
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/monitors"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -86,11 +87,11 @@ func flattenLBMembersV2(members []pools.Member) []map[string]any {
 	return m
 }
 
-func expandLBMembersV2(members *schema.Set) []pools.BatchUpdateMemberOpts {
+func expandLBMembersV2(members []any) []pools.BatchUpdateMemberOpts {
 	var m []pools.BatchUpdateMemberOpts
 
 	if members != nil {
-		for _, raw := range members.List() {
+		for _, raw := range members {
 			rawMap := raw.(map[string]any)
 			name := rawMap["name"].(string)
 			subnetID := rawMap["subnet_id"].(string)
@@ -835,6 +836,39 @@ func resourceLBV2LoadBalancerRefreshFunc(ctx context.Context, lbClient *gophercl
 	}
 }
 
+// waitForLBV2LoadBalancerUpdate waits for the load-balancer to become ACTIVE
+// after it has been updated after updatedAt. The load-balancer is reported as
+// PENDING_UPDATE until then, so that the wait does not return before an
+// asynchronous action like a failover has started.
+func waitForLBV2LoadBalancerUpdate(ctx context.Context, lbClient *gophercloud.ServiceClient, lbID string, updatedAt time.Time, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for loadbalancer %s to be updated and become ACTIVE.", lbID)
+
+	refresh := updatedAfterStateRefreshFunc(resourceLBV2LoadBalancerRefreshFunc(ctx, lbClient, lbID), func(v any) (time.Time, bool) {
+		lb, ok := v.(*loadbalancers.LoadBalancer)
+		if !ok || lb == nil {
+			return time.Time{}, false
+		}
+
+		return lb.UpdatedAt, true
+	}, updatedAt, lbPendingUpdate)
+
+	stateConf := &retry.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Pending:    getLbPendingStatuses(),
+		Refresh:    refresh,
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Error waiting for loadbalancer %s to be updated: %w", lbID, err)
+	}
+
+	return nil
+}
+
 func resourceLoadBalancerV2SetSecurityGroups(ctx context.Context, networkingClient *gophercloud.ServiceClient, vipPortID string, d *schema.ResourceData) error {
 	if vipPortID != "" {
 		if v, ok := d.GetOk("security_group_ids"); ok {
@@ -866,3 +900,31 @@ func resourceLoadBalancerV2GetSecurityGroups(ctx context.Context, networkingClie
 
 	return nil
 }
+
+// lbV2ListenerErrorAttributes maps the fields of the Octavia listener API to
+// the attributes of openstack_lb_listener_v2.
+func lbV2ListenerErrorAttributes() map[string]cty.Path {
+	return map[string]cty.Path{
+		"protocol":                    cty.GetAttrPath("protocol"),
+		"protocol_port":               cty.GetAttrPath("protocol_port"),
+		"loadbalancer_id":             cty.GetAttrPath("loadbalancer_id"),
+		"name":                        cty.GetAttrPath("name"),
+		"description":                 cty.GetAttrPath("description"),
+		"default_pool_id":             cty.GetAttrPath("default_pool_id"),
+		"connection_limit":            cty.GetAttrPath("connection_limit"),
+		"default_tls_container_ref":   cty.GetAttrPath("default_tls_container_ref"),
+		"sni_container_refs":          cty.GetAttrPath("sni_container_refs"),
+		"alpn_protocols":              cty.GetAttrPath("alpn_protocols"),
+		"client_authentication":       cty.GetAttrPath("client_authentication"),
+		"client_ca_tls_container_ref": cty.GetAttrPath("client_ca_tls_container_ref"),
+		"client_crl_container_ref":    cty.GetAttrPath("client_crl_container_ref"),
+		"tls_ciphers":                 cty.GetAttrPath("tls_ciphers"),
+		"tls_versions":                cty.GetAttrPath("tls_versions"),
+		"timeout_client_data":         cty.GetAttrPath("timeout_client_data"),
+		"timeout_member_connect":      cty.GetAttrPath("timeout_member_connect"),
+		"timeout_member_data":         cty.GetAttrPath("timeout_member_data"),
+		"timeout_tcp_inspect":         cty.GetAttrPath("timeout_tcp_inspect"),
+		"insert_headers":              cty.GetAttrPath("insert_headers"),
+		"allowed_cidrs":               cty.GetAttrPath("allowed_cidrs"),
+	}
+}
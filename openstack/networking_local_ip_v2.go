@@ -0,0 +1,313 @@
+package openstack
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// networkingLocalIPV2 represents a Neutron local IP. Gophercloud doesn't
+// support the local-ip extension.
+type networkingLocalIPV2 struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	ProjectID      string `json:"project_id"`
+	LocalPortID    string `json:"local_port_id"`
+	NetworkID      string `json:"network_id"`
+	LocalIPAddress string `json:"local_ip_address"`
+	IPMode         string `json:"ip_mode"`
+}
+
+// networkingLocalIPV2CreateOpts represents the attributes used when creating
+// a local IP.
+type networkingLocalIPV2CreateOpts struct {
+	Name           string `json:"name,omitempty"`
+	Description    string `json:"description,omitempty"`
+	ProjectID      string `json:"project_id,omitempty"`
+	LocalPortID    string `json:"local_port_id,omitempty"`
+	NetworkID      string `json:"network_id,omitempty"`
+	LocalIPAddress string `json:"local_ip_address,omitempty"`
+	IPMode         string `json:"ip_mode,omitempty"`
+}
+
+// networkingLocalIPV2UpdateOpts represents the attributes used when updating
+// a local IP.
+type networkingLocalIPV2UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// networkingLocalIPV2ListOpts represents the filters used when listing local
+// IPs.
+type networkingLocalIPV2ListOpts struct {
+	Name           string `q:"name"`
+	Description    string `q:"description"`
+	ProjectID      string `q:"project_id"`
+	LocalPortID    string `q:"local_port_id"`
+	NetworkID      string `q:"network_id"`
+	LocalIPAddress string `q:"local_ip_address"`
+	IPMode         string `q:"ip_mode"`
+}
+
+type networkingLocalIPV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets the response as a local IP.
+func (r networkingLocalIPV2Result) Extract() (*networkingLocalIPV2, error) {
+	var s struct {
+		LocalIP *networkingLocalIPV2 `json:"local_ip"`
+	}
+
+	err := r.ExtractInto(&s)
+
+	return s.LocalIP, err
+}
+
+type networkingLocalIPV2Page struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL extracts the URL of the next page of local IPs.
+func (r networkingLocalIPV2Page) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"local_ips_links"`
+	}
+
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty determines whether a page of local IPs is empty.
+func (r networkingLocalIPV2Page) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	localIPs, err := extractNetworkingLocalIPsV2(r)
+
+	return len(localIPs) == 0, err
+}
+
+func extractNetworkingLocalIPsV2(r pagination.Page) ([]networkingLocalIPV2, error) {
+	var s struct {
+		LocalIPs []networkingLocalIPV2 `json:"local_ips"`
+	}
+
+	err := (r.(networkingLocalIPV2Page)).ExtractInto(&s)
+
+	return s.LocalIPs, err
+}
+
+func networkingLocalIPV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts networkingLocalIPV2CreateOpts) (r networkingLocalIPV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "local_ip")
+	if err != nil {
+		r.Err = err
+
+		return
+	}
+
+	resp, err := client.Post(ctx, client.ServiceURL("local_ips"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func networkingLocalIPV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (r networkingLocalIPV2Result) {
+	resp, err := client.Get(ctx, client.ServiceURL("local_ips", id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func networkingLocalIPV2Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts networkingLocalIPV2UpdateOpts) (r networkingLocalIPV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "local_ip")
+	if err != nil {
+		r.Err = err
+
+		return
+	}
+
+	resp, err := client.Put(ctx, client.ServiceURL("local_ips", id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func networkingLocalIPV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(ctx, client.ServiceURL("local_ips", id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func networkingLocalIPV2List(client *gophercloud.ServiceClient, opts networkingLocalIPV2ListOpts) pagination.Pager {
+	url := client.ServiceURL("local_ips")
+
+	query, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+
+	url += query.String()
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return networkingLocalIPV2Page{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// networkingLocalIPAssociationV2 represents an association of a local IP
+// with a port.
+type networkingLocalIPAssociationV2 struct {
+	LocalIPID      string `json:"local_ip_id"`
+	LocalIPAddress string `json:"local_ip_address"`
+	FixedPortID    string `json:"fixed_port_id"`
+	FixedIP        string `json:"fixed_ip"`
+	Host           string `json:"host"`
+}
+
+// networkingLocalIPAssociationV2CreateOpts represents the attributes used
+// when associating a local IP with a port.
+type networkingLocalIPAssociationV2CreateOpts struct {
+	FixedPortID string `json:"fixed_port_id" required:"true"`
+	FixedIP     string `json:"fixed_ip,omitempty"`
+}
+
+// networkingLocalIPAssociationV2ListOpts represents the filters used when
+// listing the associations of a local IP.
+type networkingLocalIPAssociationV2ListOpts struct {
+	FixedPortID string `q:"fixed_port_id"`
+	FixedIP     string `q:"fixed_ip"`
+	Host        string `q:"host"`
+}
+
+type networkingLocalIPAssociationV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets the response as a local IP association.
+func (r networkingLocalIPAssociationV2Result) Extract() (*networkingLocalIPAssociationV2, error) {
+	var s struct {
+		PortAssociation *networkingLocalIPAssociationV2 `json:"port_association"`
+	}
+
+	err := r.ExtractInto(&s)
+
+	return s.PortAssociation, err
+}
+
+type networkingLocalIPAssociationV2Page struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL extracts the URL of the next page of local IP associations.
+func (r networkingLocalIPAssociationV2Page) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"port_associations_links"`
+	}
+
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty determines whether a page of local IP associations is empty.
+func (r networkingLocalIPAssociationV2Page) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	associations, err := extractNetworkingLocalIPAssociationsV2(r)
+
+	return len(associations) == 0, err
+}
+
+func extractNetworkingLocalIPAssociationsV2(r pagination.Page) ([]networkingLocalIPAssociationV2, error) {
+	var s struct {
+		PortAssociations []networkingLocalIPAssociationV2 `json:"port_associations"`
+	}
+
+	err := (r.(networkingLocalIPAssociationV2Page)).ExtractInto(&s)
+
+	return s.PortAssociations, err
+}
+
+func networkingLocalIPAssociationV2Create(ctx context.Context, client *gophercloud.ServiceClient, localIPID string, opts networkingLocalIPAssociationV2CreateOpts) (r networkingLocalIPAssociationV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "port_association")
+	if err != nil {
+		r.Err = err
+
+		return
+	}
+
+	resp, err := client.Post(ctx, client.ServiceURL("local_ips", localIPID, "port_associations"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func networkingLocalIPAssociationV2Delete(ctx context.Context, client *gophercloud.ServiceClient, localIPID, fixedPortID string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(ctx, client.ServiceURL("local_ips", localIPID, "port_associations", fixedPortID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func networkingLocalIPAssociationV2List(client *gophercloud.ServiceClient, localIPID string, opts networkingLocalIPAssociationV2ListOpts) pagination.Pager {
+	url := client.ServiceURL("local_ips", localIPID, "port_associations")
+
+	query, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+
+	url += query.String()
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return networkingLocalIPAssociationV2Page{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// networkingLocalIPAssociationV2Get retrieves the association of a local IP
+// with a port. The API doesn't allow to show a single association, so it is
+// looked up in the list of associations of the local IP. A 404 error is
+// returned when there is no such association.
+func networkingLocalIPAssociationV2Get(ctx context.Context, client *gophercloud.ServiceClient, localIPID, fixedPortID string) (*networkingLocalIPAssociationV2, error) {
+	listOpts := networkingLocalIPAssociationV2ListOpts{
+		FixedPortID: fixedPortID,
+	}
+
+	pages, err := networkingLocalIPAssociationV2List(client, localIPID, listOpts).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	associations, err := extractNetworkingLocalIPAssociationsV2(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range associations {
+		if a.FixedPortID == fixedPortID {
+			return &a, nil
+		}
+	}
+
+	return nil, gophercloud.ErrUnexpectedResponseCode{Actual: http.StatusNotFound}
+}
@@ -0,0 +1,78 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// networkingMeteringLabelV2 represents a Neutron metering label. Gophercloud
+// doesn't support the metering extension.
+type networkingMeteringLabelV2 struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Shared      bool   `json:"shared"`
+	ProjectID   string `json:"project_id"`
+}
+
+// networkingMeteringLabelV2CreateOpts represents the attributes used when
+// creating a metering label.
+type networkingMeteringLabelV2CreateOpts struct {
+	Name        string            `json:"name,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Shared      bool              `json:"shared,omitempty"`
+	ProjectID   string            `json:"project_id,omitempty"`
+	ValueSpecs  map[string]string `json:"value_specs,omitempty"`
+}
+
+// ToMeteringLabelCreateMap casts a networkingMeteringLabelV2CreateOpts struct
+// to a map and expands its ValueSpecs field.
+func (opts networkingMeteringLabelV2CreateOpts) ToMeteringLabelCreateMap() (map[string]any, error) {
+	return BuildRequest(opts, "metering_label")
+}
+
+type networkingMeteringLabelV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets the response as a metering label.
+func (r networkingMeteringLabelV2Result) Extract() (*networkingMeteringLabelV2, error) {
+	var s struct {
+		MeteringLabel *networkingMeteringLabelV2 `json:"metering_label"`
+	}
+
+	err := r.ExtractInto(&s)
+
+	return s.MeteringLabel, err
+}
+
+func networkingMeteringLabelV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts networkingMeteringLabelV2CreateOpts) (r networkingMeteringLabelV2Result) {
+	b, err := opts.ToMeteringLabelCreateMap()
+	if err != nil {
+		r.Err = err
+
+		return
+	}
+
+	resp, err := client.Post(ctx, client.ServiceURL("metering", "metering-labels"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func networkingMeteringLabelV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (r networkingMeteringLabelV2Result) {
+	resp, err := client.Get(ctx, client.ServiceURL("metering", "metering-labels", id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func networkingMeteringLabelV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(ctx, client.ServiceURL("metering", "metering-labels", id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
@@ -0,0 +1,61 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitNetworkingMeteringLabelV2CreateOptsToMap(t *testing.T) {
+	opts := networkingMeteringLabelV2CreateOpts{
+		Name:   "label_1",
+		Shared: true,
+		ValueSpecs: map[string]string{
+			"key": "value",
+		},
+	}
+
+	expected := map[string]any{
+		"metering_label": map[string]any{
+			"name":   "label_1",
+			"shared": true,
+			"key":    "value",
+		},
+	}
+
+	actual, err := opts.ToMeteringLabelCreateMap()
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitNetworkingMeteringLabelRuleV2CreateOptsToMap(t *testing.T) {
+	opts := networkingMeteringLabelRuleV2CreateOpts{
+		MeteringLabelID:     "bc91b832-8465-40a7-a5d8-ba87de442266",
+		Direction:           "egress",
+		SourceIPPrefix:      "10.0.0.0/24",
+		DestinationIPPrefix: "0.0.0.0/0",
+		Excluded:            true,
+		ValueSpecs: map[string]string{
+			"enabled": "true",
+		},
+	}
+
+	expected := map[string]any{
+		"metering_label_rule": map[string]any{
+			"metering_label_id":     "bc91b832-8465-40a7-a5d8-ba87de442266",
+			"direction":             "egress",
+			"source_ip_prefix":      "10.0.0.0/24",
+			"destination_ip_prefix": "0.0.0.0/0",
+			"excluded":              true,
+			"enabled":               true,
+		},
+	}
+
+	actual, err := opts.ToMeteringLabelRuleCreateMap()
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	_, err = networkingMeteringLabelRuleV2CreateOpts{}.ToMeteringLabelRuleCreateMap()
+	assert.Error(t, err)
+}
@@ -0,0 +1,164 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// networkingNDPProxyV2 represents a Neutron NDP proxy. Gophercloud doesn't
+// support the l3-ndp-proxy extension.
+type networkingNDPProxyV2 struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ProjectID   string `json:"project_id"`
+	RouterID    string `json:"router_id"`
+	PortID      string `json:"port_id"`
+	IPAddress   string `json:"ip_address"`
+}
+
+// networkingNDPProxyV2CreateOpts represents the attributes used when
+// creating an NDP proxy.
+type networkingNDPProxyV2CreateOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	ProjectID   string `json:"project_id,omitempty"`
+	RouterID    string `json:"router_id" required:"true"`
+	PortID      string `json:"port_id" required:"true"`
+	IPAddress   string `json:"ip_address,omitempty"`
+}
+
+// networkingNDPProxyV2UpdateOpts represents the attributes used when
+// updating an NDP proxy.
+type networkingNDPProxyV2UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// networkingNDPProxyV2ListOpts represents the filters used when listing NDP
+// proxies.
+type networkingNDPProxyV2ListOpts struct {
+	Name        string `q:"name"`
+	Description string `q:"description"`
+	ProjectID   string `q:"project_id"`
+	RouterID    string `q:"router_id"`
+	PortID      string `q:"port_id"`
+	IPAddress   string `q:"ip_address"`
+}
+
+type networkingNDPProxyV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets the response as an NDP proxy.
+func (r networkingNDPProxyV2Result) Extract() (*networkingNDPProxyV2, error) {
+	var s struct {
+		NDPProxy *networkingNDPProxyV2 `json:"ndp_proxy"`
+	}
+
+	err := r.ExtractInto(&s)
+
+	return s.NDPProxy, err
+}
+
+type networkingNDPProxyV2Page struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL extracts the URL of the next page of NDP proxies.
+func (r networkingNDPProxyV2Page) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"ndp_proxies_links"`
+	}
+
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty determines whether a page of NDP proxies is empty.
+func (r networkingNDPProxyV2Page) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	ndpProxies, err := extractNetworkingNDPProxiesV2(r)
+
+	return len(ndpProxies) == 0, err
+}
+
+func extractNetworkingNDPProxiesV2(r pagination.Page) ([]networkingNDPProxyV2, error) {
+	var s struct {
+		NDPProxies []networkingNDPProxyV2 `json:"ndp_proxies"`
+	}
+
+	err := (r.(networkingNDPProxyV2Page)).ExtractInto(&s)
+
+	return s.NDPProxies, err
+}
+
+func networkingNDPProxyV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts networkingNDPProxyV2CreateOpts) (r networkingNDPProxyV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "ndp_proxy")
+	if err != nil {
+		r.Err = err
+
+		return
+	}
+
+	resp, err := client.Post(ctx, client.ServiceURL("ndp_proxies"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func networkingNDPProxyV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (r networkingNDPProxyV2Result) {
+	resp, err := client.Get(ctx, client.ServiceURL("ndp_proxies", id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func networkingNDPProxyV2Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts networkingNDPProxyV2UpdateOpts) (r networkingNDPProxyV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "ndp_proxy")
+	if err != nil {
+		r.Err = err
+
+		return
+	}
+
+	resp, err := client.Put(ctx, client.ServiceURL("ndp_proxies", id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func networkingNDPProxyV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(ctx, client.ServiceURL("ndp_proxies", id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func networkingNDPProxyV2List(client *gophercloud.ServiceClient, opts networkingNDPProxyV2ListOpts) pagination.Pager {
+	url := client.ServiceURL("ndp_proxies")
+
+	query, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+
+	url += query.String()
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return networkingNDPProxyV2Page{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
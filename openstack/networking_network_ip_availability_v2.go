@@ -0,0 +1,46 @@
+package openstack
+
+import (
+	"math/big"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/networkipavailabilities"
+)
+
+// networkingNetworkIPAvailabilityV2FreeIPs returns the number of free IP
+// addresses. The counters are strings, since IPv6 subnets exceed int64.
+func networkingNetworkIPAvailabilityV2FreeIPs(total, used string) string {
+	t, ok := new(big.Int).SetString(total, 10)
+	if !ok {
+		return ""
+	}
+
+	u, ok := new(big.Int).SetString(used, 10)
+	if !ok {
+		return ""
+	}
+
+	free := new(big.Int).Sub(t, u)
+	if free.Sign() < 0 {
+		return "0"
+	}
+
+	return free.String()
+}
+
+func flattenNetworkingNetworkIPAvailabilityV2Subnets(subnets []networkipavailabilities.SubnetIPAvailability) []map[string]any {
+	res := make([]map[string]any, 0, len(subnets))
+
+	for _, s := range subnets {
+		res = append(res, map[string]any{
+			"subnet_id":   s.SubnetID,
+			"subnet_name": s.SubnetName,
+			"cidr":        s.CIDR,
+			"ip_version":  s.IPVersion,
+			"total_ips":   s.TotalIPs,
+			"used_ips":    s.UsedIPs,
+			"free_ips":    networkingNetworkIPAvailabilityV2FreeIPs(s.TotalIPs, s.UsedIPs),
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,42 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/networkipavailabilities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitNetworkingNetworkIPAvailabilityV2FreeIPs(t *testing.T) {
+	assert.Equal(t, "200", networkingNetworkIPAvailabilityV2FreeIPs("253", "53"))
+	assert.Equal(t, "18446744073709551610", networkingNetworkIPAvailabilityV2FreeIPs("18446744073709551614", "4"))
+	assert.Equal(t, "0", networkingNetworkIPAvailabilityV2FreeIPs("2", "3"))
+	assert.Empty(t, networkingNetworkIPAvailabilityV2FreeIPs("", "3"))
+}
+
+func TestUnitFlattenNetworkingNetworkIPAvailabilityV2Subnets(t *testing.T) {
+	subnets := []networkipavailabilities.SubnetIPAvailability{
+		{
+			SubnetID:   "54d6f61d-db07-451c-9ab3-b9609b6b6f0b",
+			SubnetName: "subnet_1",
+			CIDR:       "192.168.199.0/24",
+			IPVersion:  4,
+			TotalIPs:   "253",
+			UsedIPs:    "3",
+		},
+	}
+
+	expected := []map[string]any{
+		{
+			"subnet_id":   "54d6f61d-db07-451c-9ab3-b9609b6b6f0b",
+			"subnet_name": "subnet_1",
+			"cidr":        "192.168.199.0/24",
+			"ip_version":  4,
+			"total_ips":   "253",
+			"used_ips":    "3",
+			"free_ips":    "250",
+		},
+	}
+
+	assert.Equal(t, expected, flattenNetworkingNetworkIPAvailabilityV2Subnets(subnets))
+}
@@ -41,28 +41,9 @@ func networkingNetworkV2ID(ctx context.Context, d *schema.ResourceData, meta any
 		return "", fmt.Errorf("Error creating OpenStack network client: %w", err)
 	}
 
-	opts := networks.ListOpts{Name: networkName}
-	pager := networks.List(networkingClient, opts)
-	networkID := ""
+	network, err := networkingNetworkV2Find(ctx, config.lookupCache, networkingClient, "pool_name", networkName, networks.ListOpts{Name: networkName})
 
-	err = pager.EachPage(ctx, func(_ context.Context, page pagination.Page) (bool, error) {
-		networkList, err := networks.ExtractNetworks(page)
-		if err != nil {
-			return false, err
-		}
-
-		for _, n := range networkList {
-			if n.Name == networkName {
-				networkID = n.ID
-
-				return false, nil
-			}
-		}
-
-		return true, nil
-	})
-
-	return networkID, err
+	return network.ID, err
 }
 
 // networkingNetworkV2Name retrieves network name by the provided ID.
@@ -74,28 +55,43 @@ func networkingNetworkV2Name(ctx context.Context, d *schema.ResourceData, meta a
 		return "", fmt.Errorf("Error creating OpenStack network client: %w", err)
 	}
 
-	opts := networks.ListOpts{ID: networkID}
-	pager := networks.List(networkingClient, opts)
-	networkName := ""
+	network, err := networkingNetworkV2Find(ctx, config.lookupCache, networkingClient, "pool_id", networkID, networks.ListOpts{ID: networkID})
 
-	err = pager.EachPage(ctx, func(_ context.Context, page pagination.Page) (bool, error) {
-		networkList, err := networks.ExtractNetworks(page)
-		if err != nil {
-			return false, err
-		}
+	return network.Name, err
+}
+
+// networkingNetworkV2Find returns the first network matching the list
+// options and the query term, which is either the name or the ID of the
+// network. Found networks are cached in the lookup cache. An empty network is
+// returned, when nothing is found.
+func networkingNetworkV2Find(ctx context.Context, cache *lookupCache, client *gophercloud.ServiceClient, query, term string, opts networks.ListOpts) (networks.Network, error) {
+	v, err := cache.lookup(client, lookupCacheNetwork, query, term, func() (string, string, any, error) {
+		var network networks.Network
+
+		err := networks.List(client, opts).EachPage(ctx, func(_ context.Context, page pagination.Page) (bool, error) {
+			networkList, err := networks.ExtractNetworks(page)
+			if err != nil {
+				return false, err
+			}
 
-		for _, n := range networkList {
-			if n.ID == networkID {
-				networkName = n.Name
+			for _, n := range networkList {
+				if (opts.Name != "" && n.Name == opts.Name) || (opts.ID != "" && n.ID == opts.ID) {
+					network = n
 
-				return false, nil
+					return false, nil
+				}
 			}
-		}
 
-		return true, nil
+			return true, nil
+		})
+
+		return network.ID, network.Name, network, err
 	})
+	if err != nil {
+		return networks.Network{}, err
+	}
 
-	return networkName, err
+	return v.(networks.Network), nil
 }
 
 func resourceNetworkingNetworkV2StateRefreshFunc(ctx context.Context, client *gophercloud.ServiceClient, networkID string) retry.StateRefreshFunc {
@@ -15,6 +15,7 @@ import (
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/portsecurity"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-provider-openstack/utils/v2/hashcode"
@@ -259,3 +260,24 @@ func flattenNetworkingPortBindingV2(port portExtended) any {
 
 	return portBinding
 }
+
+// networkingPortV2ErrorAttributes maps the fields of the Neutron port API to
+// the attributes of openstack_networking_port_v2.
+func networkingPortV2ErrorAttributes() map[string]cty.Path {
+	return map[string]cty.Path{
+		"network_id":            cty.GetAttrPath("network_id"),
+		"name":                  cty.GetAttrPath("name"),
+		"description":           cty.GetAttrPath("description"),
+		"admin_state_up":        cty.GetAttrPath("admin_state_up"),
+		"mac_address":           cty.GetAttrPath("mac_address"),
+		"device_owner":          cty.GetAttrPath("device_owner"),
+		"device_id":             cty.GetAttrPath("device_id"),
+		"allowed_address_pairs": cty.GetAttrPath("allowed_address_pairs"),
+		"dns_name":              cty.GetAttrPath("dns_name"),
+		"qos_policy_id":         cty.GetAttrPath("qos_policy_id"),
+		"port_security_enabled": cty.GetAttrPath("port_security_enabled"),
+		"fixed_ips":             cty.GetAttrPath("fixed_ip"),
+		"security_groups":       cty.GetAttrPath("security_group_ids"),
+		"extra_dhcp_opts":       cty.GetAttrPath("extra_dhcp_option"),
+	}
+}
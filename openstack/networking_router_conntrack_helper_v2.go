@@ -0,0 +1,93 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// networkingRouterConntrackHelperV2 represents a Neutron router conntrack
+// helper. Gophercloud doesn't support the l3-conntrack-helper extension.
+type networkingRouterConntrackHelperV2 struct {
+	ID       string `json:"id"`
+	Protocol string `json:"protocol"`
+	Port     int    `json:"port"`
+	Helper   string `json:"helper"`
+}
+
+// networkingRouterConntrackHelperV2CreateOpts represents the attributes used
+// when creating a router conntrack helper.
+type networkingRouterConntrackHelperV2CreateOpts struct {
+	Protocol string `json:"protocol" required:"true"`
+	Port     int    `json:"port" required:"true"`
+	Helper   string `json:"helper" required:"true"`
+}
+
+// networkingRouterConntrackHelperV2UpdateOpts represents the attributes used
+// when updating a router conntrack helper.
+type networkingRouterConntrackHelperV2UpdateOpts struct {
+	Protocol *string `json:"protocol,omitempty"`
+	Port     *int    `json:"port,omitempty"`
+	Helper   *string `json:"helper,omitempty"`
+}
+
+type networkingRouterConntrackHelperV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets the response as a router conntrack helper.
+func (r networkingRouterConntrackHelperV2Result) Extract() (*networkingRouterConntrackHelperV2, error) {
+	var s struct {
+		ConntrackHelper *networkingRouterConntrackHelperV2 `json:"conntrack_helper"`
+	}
+
+	err := r.ExtractInto(&s)
+
+	return s.ConntrackHelper, err
+}
+
+func networkingRouterConntrackHelperV2Create(ctx context.Context, client *gophercloud.ServiceClient, routerID string, opts networkingRouterConntrackHelperV2CreateOpts) (r networkingRouterConntrackHelperV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "conntrack_helper")
+	if err != nil {
+		r.Err = err
+
+		return
+	}
+
+	resp, err := client.Post(ctx, client.ServiceURL("routers", routerID, "conntrack_helpers"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func networkingRouterConntrackHelperV2Get(ctx context.Context, client *gophercloud.ServiceClient, routerID, id string) (r networkingRouterConntrackHelperV2Result) {
+	resp, err := client.Get(ctx, client.ServiceURL("routers", routerID, "conntrack_helpers", id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func networkingRouterConntrackHelperV2Update(ctx context.Context, client *gophercloud.ServiceClient, routerID, id string, opts networkingRouterConntrackHelperV2UpdateOpts) (r networkingRouterConntrackHelperV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "conntrack_helper")
+	if err != nil {
+		r.Err = err
+
+		return
+	}
+
+	resp, err := client.Put(ctx, client.ServiceURL("routers", routerID, "conntrack_helpers", id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func networkingRouterConntrackHelperV2Delete(ctx context.Context, client *gophercloud.ServiceClient, routerID, id string) (r gophercloud.ErrResult) {
+	resp, err := client.Delete(ctx, client.ServiceURL("routers", routerID, "conntrack_helpers", id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
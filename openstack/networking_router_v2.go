@@ -3,12 +3,58 @@ package openstack
 import (
 	"context"
 	"net/http"
+	"slices"
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// networkingRouterV2Multihoming represents the attributes of the
+// external-gateway-multihoming and default route extensions, which
+// routers.Router doesn't support.
+type networkingRouterV2Multihoming struct {
+	ExternalGateways       []routers.GatewayInfo `json:"external_gateways"`
+	EnableDefaultRouteECMP bool                  `json:"enable_default_route_ecmp"`
+	EnableDefaultRouteBFD  bool                  `json:"enable_default_route_bfd"`
+}
+
+// networkingRouterV2ExternalGatewaysOpts represents the external gateways to
+// add, update or remove.
+type networkingRouterV2ExternalGatewaysOpts struct {
+	ExternalGateways []routers.GatewayInfo `json:"external_gateways"`
+}
+
+func networkingRouterV2ExternalGatewaysAction(ctx context.Context, client *gophercloud.ServiceClient, id, action string, gateways []routers.GatewayInfo) (r routers.UpdateResult) {
+	b, err := gophercloud.BuildRequestBody(networkingRouterV2ExternalGatewaysOpts{gateways}, "router")
+	if err != nil {
+		r.Err = err
+
+		return
+	}
+
+	resp, err := client.Put(ctx, client.ServiceURL("routers", id, action), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func networkingRouterV2AddExternalGateways(ctx context.Context, client *gophercloud.ServiceClient, id string, gateways []routers.GatewayInfo) routers.UpdateResult {
+	return networkingRouterV2ExternalGatewaysAction(ctx, client, id, "add_external_gateways", gateways)
+}
+
+func networkingRouterV2UpdateExternalGateways(ctx context.Context, client *gophercloud.ServiceClient, id string, gateways []routers.GatewayInfo) routers.UpdateResult {
+	return networkingRouterV2ExternalGatewaysAction(ctx, client, id, "update_external_gateways", gateways)
+}
+
+func networkingRouterV2RemoveExternalGateways(ctx context.Context, client *gophercloud.ServiceClient, id string, gateways []routers.GatewayInfo) routers.UpdateResult {
+	return networkingRouterV2ExternalGatewaysAction(ctx, client, id, "remove_external_gateways", gateways)
+}
+
 func resourceNetworkingRouterV2StateRefreshFunc(ctx context.Context, client *gophercloud.ServiceClient, routerID string) retry.StateRefreshFunc {
 	return func() (any, string, error) {
 		n, err := routers.Get(ctx, client, routerID).Extract()
@@ -63,3 +109,210 @@ func flattenNetworkingRouterExternalFixedIPsV2(externalFixedIPs []routers.Extern
 
 	return fixedIPs
 }
+
+// expandNetworkingRouterAdditionalExternalGatewaysV2 expands the additional
+// external gateways. enable_snat is only set if it is configured, since
+// setting it is restricted to admin users by default. external_fixed_ip is
+// only set if it is configured, since the planned value of a gateway without
+// configured fixed IPs is the one of the gateway at the same position in the
+// state.
+func expandNetworkingRouterAdditionalExternalGatewaysV2(d *schema.ResourceData, additionalGateways []any) []routers.GatewayInfo {
+	gateways := make([]routers.GatewayInfo, len(additionalGateways))
+
+	for i, raw := range additionalGateways {
+		rawMap := raw.(map[string]any)
+		rawConfig := networkingRouterV2AdditionalGatewayRawConfig(d, i)
+
+		gateways[i] = routers.GatewayInfo{
+			NetworkID: rawMap["network_id"].(string),
+		}
+
+		if !rawConfig.IsNull() && !rawConfig.GetAttr("enable_snat").IsNull() {
+			enableSNAT := rawMap["enable_snat"].(bool)
+			gateways[i].EnableSNAT = &enableSNAT
+		}
+
+		if !rawConfig.IsNull() {
+			if fixedIPs := rawConfig.GetAttr("external_fixed_ip"); fixedIPs.IsKnown() && !fixedIPs.IsNull() && fixedIPs.LengthInt() > 0 {
+				gateways[i].ExternalFixedIPs = expandNetworkingRouterExternalFixedIPsV2(rawMap["external_fixed_ip"].([]any))
+			}
+		}
+	}
+
+	return gateways
+}
+
+// expandNetworkingRouterStateAdditionalExternalGatewaysV2 expands the
+// additional external gateways of the state, whose attributes are all known.
+func expandNetworkingRouterStateAdditionalExternalGatewaysV2(additionalGateways []any) []routers.GatewayInfo {
+	gateways := make([]routers.GatewayInfo, len(additionalGateways))
+
+	for i, raw := range additionalGateways {
+		rawMap := raw.(map[string]any)
+		enableSNAT := rawMap["enable_snat"].(bool)
+
+		gateways[i] = routers.GatewayInfo{
+			NetworkID:        rawMap["network_id"].(string),
+			EnableSNAT:       &enableSNAT,
+			ExternalFixedIPs: expandNetworkingRouterExternalFixedIPsV2(rawMap["external_fixed_ip"].([]any)),
+		}
+	}
+
+	return gateways
+}
+
+// networkingRouterV2AdditionalGatewayRawConfig returns the configuration of
+// the additional external gateway at the given index. It is null, if the
+// configuration isn't available.
+func networkingRouterV2AdditionalGatewayRawConfig(d *schema.ResourceData, i int) cty.Value {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+
+	gateways := rawConfig.GetAttr("additional_external_gateway")
+	if gateways.IsNull() || !gateways.IsKnown() || gateways.LengthInt() <= i {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+
+	gateway := gateways.Index(cty.NumberIntVal(int64(i)))
+	if !gateway.IsKnown() {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+
+	return gateway
+}
+
+func flattenNetworkingRouterAdditionalExternalGatewaysV2(externalGateways []routers.GatewayInfo) []map[string]any {
+	// The first external gateway is the one of external_network_id.
+	if len(externalGateways) < 2 {
+		return []map[string]any{}
+	}
+
+	gateways := make([]map[string]any, 0, len(externalGateways)-1)
+
+	for _, gateway := range externalGateways[1:] {
+		var enableSNAT bool
+		if gateway.EnableSNAT != nil {
+			enableSNAT = *gateway.EnableSNAT
+		}
+
+		gateways = append(gateways, map[string]any{
+			"network_id":        gateway.NetworkID,
+			"enable_snat":       enableSNAT,
+			"external_fixed_ip": flattenNetworkingRouterExternalFixedIPsV2(gateway.ExternalFixedIPs),
+		})
+	}
+
+	return gateways
+}
+
+// networkingRouterV2MatchExternalGateways matches the gateways to the old
+// gateways by their network and fixed IPs and returns the index of the
+// matched old gateway, or -1, for every gateway. Gateways with the same fixed
+// IPs are matched first. The remaining gateways without fixed IPs, or all
+// remaining gateways if anyFixedIPs is set, then match any old gateway on the
+// same network.
+func networkingRouterV2MatchExternalGateways(oldGateways, gateways []routers.GatewayInfo, anyFixedIPs bool) []int {
+	matches := make([]int, len(gateways))
+	matched := make([]bool, len(oldGateways))
+
+	for _, exact := range []bool{true, false} {
+		for i, n := range gateways {
+			if exact {
+				matches[i] = -1
+			} else if matches[i] >= 0 || (len(n.ExternalFixedIPs) > 0 && !anyFixedIPs) {
+				continue
+			}
+
+			for j, o := range oldGateways {
+				if matched[j] || o.NetworkID != n.NetworkID {
+					continue
+				}
+
+				if exact && !slices.Equal(o.ExternalFixedIPs, n.ExternalFixedIPs) {
+					continue
+				}
+
+				matches[i] = j
+				matched[j] = true
+
+				break
+			}
+		}
+	}
+
+	return matches
+}
+
+// networkingRouterV2ExternalGatewaysChanges matches the old and the new
+// additional external gateways by their network and fixed IPs, so reordering
+// the gateways doesn't recreate them. A new gateway without fixed IPs matches
+// any old gateway on the same network. A matched gateway is updated in place
+// when its enable_snat changes, the other old gateways are removed and the
+// other new gateways are added.
+func networkingRouterV2ExternalGatewaysChanges(oldGateways, newGateways []routers.GatewayInfo) ([]routers.GatewayInfo, []routers.GatewayInfo, []routers.GatewayInfo) {
+	var remove, update, add []routers.GatewayInfo
+
+	matches := networkingRouterV2MatchExternalGateways(oldGateways, newGateways, false)
+	matched := make([]bool, len(oldGateways))
+
+	for i, n := range newGateways {
+		if matches[i] < 0 {
+			add = append(add, n)
+
+			continue
+		}
+
+		matched[matches[i]] = true
+
+		o := oldGateways[matches[i]]
+		if n.EnableSNAT != nil && (o.EnableSNAT == nil || *o.EnableSNAT != *n.EnableSNAT) {
+			gateway := networkingRouterV2ExternalGatewayID(o)
+			gateway.EnableSNAT = n.EnableSNAT
+			update = append(update, gateway)
+		}
+	}
+
+	for j, o := range oldGateways {
+		if !matched[j] {
+			remove = append(remove, networkingRouterV2ExternalGatewayID(o))
+		}
+	}
+
+	return remove, update, add
+}
+
+// orderNetworkingRouterAdditionalExternalGatewaysV2 orders the additional
+// external gateways of a router like the current ones, so that the order of
+// the configuration is kept. Gateways, which aren't part of the current
+// ones, are appended.
+func orderNetworkingRouterAdditionalExternalGatewaysV2(current, gateways []routers.GatewayInfo) []routers.GatewayInfo {
+	matches := networkingRouterV2MatchExternalGateways(gateways, current, true)
+	matched := make([]bool, len(gateways))
+	ordered := make([]routers.GatewayInfo, 0, len(gateways))
+
+	for _, j := range matches {
+		if j >= 0 {
+			ordered = append(ordered, gateways[j])
+			matched[j] = true
+		}
+	}
+
+	for j, gateway := range gateways {
+		if !matched[j] {
+			ordered = append(ordered, gateway)
+		}
+	}
+
+	return ordered
+}
+
+// networkingRouterV2ExternalGatewayID returns the attributes which identify
+// an external gateway of a router.
+func networkingRouterV2ExternalGatewayID(gateway routers.GatewayInfo) routers.GatewayInfo {
+	return routers.GatewayInfo{
+		NetworkID:        gateway.NetworkID,
+		ExternalFixedIPs: gateway.ExternalFixedIPs,
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUnitExpandNetworkingRouterExternalFixedIPsV2(t *testing.T) {
@@ -66,3 +67,203 @@ func TestUnitFlattenNetworkingRouterExternalFixedIPsV2(t *testing.T) {
 
 	assert.ElementsMatch(t, expectedExternalFixedIPs, actualExternalFixedIPs)
 }
+
+func TestUnitFlattenNetworkingRouterAdditionalExternalGatewaysV2(t *testing.T) {
+	enableSNAT := true
+
+	externalGateways := []routers.GatewayInfo{
+		{
+			NetworkID: "network_1",
+			ExternalFixedIPs: []routers.ExternalFixedIP{
+				{SubnetID: "subnet_1", IPAddress: "192.168.101.1"},
+			},
+		},
+		{
+			NetworkID:  "network_2",
+			EnableSNAT: &enableSNAT,
+			ExternalFixedIPs: []routers.ExternalFixedIP{
+				{SubnetID: "subnet_2", IPAddress: "192.168.201.1"},
+			},
+		},
+	}
+
+	expected := []map[string]any{
+		{
+			"network_id":  "network_2",
+			"enable_snat": true,
+			"external_fixed_ip": []map[string]string{
+				{"subnet_id": "subnet_2", "ip_address": "192.168.201.1"},
+			},
+		},
+	}
+
+	assert.Equal(t, expected, flattenNetworkingRouterAdditionalExternalGatewaysV2(externalGateways))
+	assert.Empty(t, flattenNetworkingRouterAdditionalExternalGatewaysV2(externalGateways[:1]))
+	assert.Empty(t, flattenNetworkingRouterAdditionalExternalGatewaysV2(nil))
+}
+
+func TestUnitNetworkingRouterV2ExternalGatewaysChanges(t *testing.T) {
+	enableSNAT := true
+	disableSNAT := false
+
+	fixedIPs1 := []routers.ExternalFixedIP{{SubnetID: "subnet_1", IPAddress: "192.168.101.1"}}
+	fixedIPs2 := []routers.ExternalFixedIP{{SubnetID: "subnet_2", IPAddress: "192.168.201.1"}}
+	fixedIPs3 := []routers.ExternalFixedIP{{SubnetID: "subnet_3", IPAddress: "192.168.31.1"}}
+
+	oldGateways := []routers.GatewayInfo{
+		{NetworkID: "network_1", EnableSNAT: &enableSNAT, ExternalFixedIPs: fixedIPs1},
+		{NetworkID: "network_2", EnableSNAT: &enableSNAT, ExternalFixedIPs: fixedIPs2},
+		{NetworkID: "network_3", EnableSNAT: &enableSNAT, ExternalFixedIPs: fixedIPs3},
+	}
+
+	newGateways := []routers.GatewayInfo{
+		// Unchanged, enable_snat is not configured.
+		{NetworkID: "network_1", ExternalFixedIPs: fixedIPs1},
+		// enable_snat is changed.
+		{NetworkID: "network_2", EnableSNAT: &disableSNAT},
+		// The network is changed.
+		{NetworkID: "network_4"},
+		// A new gateway.
+		{NetworkID: "network_5"},
+	}
+
+	expectedRemove := []routers.GatewayInfo{
+		{NetworkID: "network_3", ExternalFixedIPs: fixedIPs3},
+	}
+	expectedUpdate := []routers.GatewayInfo{
+		{NetworkID: "network_2", EnableSNAT: &disableSNAT, ExternalFixedIPs: fixedIPs2},
+	}
+	expectedAdd := []routers.GatewayInfo{
+		{NetworkID: "network_4"},
+		{NetworkID: "network_5"},
+	}
+
+	remove, update, add := networkingRouterV2ExternalGatewaysChanges(oldGateways, newGateways)
+
+	assert.Equal(t, expectedRemove, remove)
+	assert.Equal(t, expectedUpdate, update)
+	assert.Equal(t, expectedAdd, add)
+
+	// Reordered gateways are kept.
+	newGateways = []routers.GatewayInfo{
+		{NetworkID: "network_3", ExternalFixedIPs: fixedIPs3},
+		{NetworkID: "network_1"},
+		{NetworkID: "network_2", EnableSNAT: &enableSNAT, ExternalFixedIPs: fixedIPs2},
+	}
+
+	remove, update, add = networkingRouterV2ExternalGatewaysChanges(oldGateways, newGateways)
+
+	assert.Empty(t, remove)
+	assert.Empty(t, update)
+	assert.Empty(t, add)
+
+	// Gateways on the same network are matched by their fixed IPs.
+	fixedIPs4 := []routers.ExternalFixedIP{{SubnetID: "subnet_1", IPAddress: "192.168.101.2"}}
+	oldGateways = []routers.GatewayInfo{
+		{NetworkID: "network_1", EnableSNAT: &enableSNAT, ExternalFixedIPs: fixedIPs1},
+		{NetworkID: "network_1", EnableSNAT: &enableSNAT, ExternalFixedIPs: fixedIPs4},
+	}
+	newGateways = []routers.GatewayInfo{
+		{NetworkID: "network_1", EnableSNAT: &disableSNAT},
+		{NetworkID: "network_1", ExternalFixedIPs: fixedIPs1},
+	}
+
+	remove, update, add = networkingRouterV2ExternalGatewaysChanges(oldGateways, newGateways)
+
+	assert.Empty(t, remove)
+	assert.Equal(t, []routers.GatewayInfo{{NetworkID: "network_1", EnableSNAT: &disableSNAT, ExternalFixedIPs: fixedIPs4}}, update)
+	assert.Empty(t, add)
+
+	remove, update, add = networkingRouterV2ExternalGatewaysChanges(oldGateways[:1], nil)
+
+	assert.Equal(t, []routers.GatewayInfo{{NetworkID: "network_1", ExternalFixedIPs: fixedIPs1}}, remove)
+	assert.Empty(t, update)
+	assert.Empty(t, add)
+}
+
+func TestUnitOrderNetworkingRouterAdditionalExternalGatewaysV2(t *testing.T) {
+	fixedIPs1 := []routers.ExternalFixedIP{{SubnetID: "subnet_1", IPAddress: "192.168.101.1"}}
+	fixedIPs2 := []routers.ExternalFixedIP{{SubnetID: "subnet_1", IPAddress: "192.168.101.2"}}
+	fixedIPs3 := []routers.ExternalFixedIP{{SubnetID: "subnet_3", IPAddress: "192.168.31.1"}}
+	fixedIPs4 := []routers.ExternalFixedIP{{SubnetID: "subnet_3", IPAddress: "192.168.31.2"}}
+
+	gateways := []routers.GatewayInfo{
+		{NetworkID: "network_1", ExternalFixedIPs: fixedIPs1},
+		{NetworkID: "network_1", ExternalFixedIPs: fixedIPs2},
+		{NetworkID: "network_2"},
+		{NetworkID: "network_3", ExternalFixedIPs: fixedIPs4},
+		{NetworkID: "network_4"},
+	}
+
+	current := []routers.GatewayInfo{
+		// A gateway without fixed IPs.
+		{NetworkID: "network_3"},
+		{NetworkID: "network_1", ExternalFixedIPs: fixedIPs2},
+		// A gateway, whose fixed IPs are stale.
+		{NetworkID: "network_2", ExternalFixedIPs: fixedIPs3},
+		{NetworkID: "network_1", ExternalFixedIPs: fixedIPs1},
+		// A removed gateway.
+		{NetworkID: "network_5"},
+	}
+
+	expected := []routers.GatewayInfo{
+		{NetworkID: "network_3", ExternalFixedIPs: fixedIPs4},
+		{NetworkID: "network_1", ExternalFixedIPs: fixedIPs2},
+		{NetworkID: "network_2"},
+		{NetworkID: "network_1", ExternalFixedIPs: fixedIPs1},
+		{NetworkID: "network_4"},
+	}
+
+	assert.Equal(t, expected, orderNetworkingRouterAdditionalExternalGatewaysV2(current, gateways))
+	assert.Equal(t, gateways, orderNetworkingRouterAdditionalExternalGatewaysV2(nil, gateways))
+}
+
+func TestUnitExpandNetworkingRouterAdditionalExternalGatewaysV2(t *testing.T) {
+	r := resourceNetworkingRouterV2()
+	d := r.TestResourceData()
+
+	additionalGateways := []any{
+		map[string]any{
+			"network_id":  "network_1",
+			"enable_snat": true,
+			"external_fixed_ip": []any{
+				map[string]any{"subnet_id": "subnet_1", "ip_address": "192.168.101.1"},
+			},
+		},
+	}
+
+	// Without a configuration, only the network is set.
+	expected := []routers.GatewayInfo{{NetworkID: "network_1"}}
+	assert.Equal(t, expected, expandNetworkingRouterAdditionalExternalGatewaysV2(d, additionalGateways))
+
+	enableSNAT := true
+	expected = []routers.GatewayInfo{
+		{
+			NetworkID:        "network_1",
+			EnableSNAT:       &enableSNAT,
+			ExternalFixedIPs: []routers.ExternalFixedIP{{SubnetID: "subnet_1", IPAddress: "192.168.101.1"}},
+		},
+	}
+	assert.Equal(t, expected, expandNetworkingRouterStateAdditionalExternalGatewaysV2(additionalGateways))
+}
+
+func TestUnitRouterUpdateOptsToMap(t *testing.T) {
+	ecmp := true
+	updateOpts := RouterUpdateOpts{
+		UpdateOpts: routers.UpdateOpts{
+			Name: "router_1",
+		},
+		EnableDefaultRouteECMP: &ecmp,
+	}
+
+	expected := map[string]any{
+		"router": map[string]any{
+			"name":                      "router_1",
+			"enable_default_route_ecmp": true,
+		},
+	}
+
+	actual, err := updateOpts.ToRouterUpdateMap()
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
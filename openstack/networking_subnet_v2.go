@@ -8,6 +8,7 @@ import (
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
@@ -158,3 +159,24 @@ func networkingSubnetV2DNSNameserverAreUnique(raw []any) error {
 
 	return nil
 }
+
+// networkingSubnetV2ErrorAttributes maps the fields of the Neutron subnet API
+// to the attributes of openstack_networking_subnet_v2.
+func networkingSubnetV2ErrorAttributes() map[string]cty.Path {
+	return map[string]cty.Path{
+		"network_id":        cty.GetAttrPath("network_id"),
+		"cidr":              cty.GetAttrPath("cidr"),
+		"name":              cty.GetAttrPath("name"),
+		"description":       cty.GetAttrPath("description"),
+		"gateway_ip":        cty.GetAttrPath("gateway_ip"),
+		"ip_version":        cty.GetAttrPath("ip_version"),
+		"dns_nameservers":   cty.GetAttrPath("dns_nameservers"),
+		"ipv6_address_mode": cty.GetAttrPath("ipv6_address_mode"),
+		"ipv6_ra_mode":      cty.GetAttrPath("ipv6_ra_mode"),
+		"segment_id":        cty.GetAttrPath("segment_id"),
+		"subnetpool_id":     cty.GetAttrPath("subnetpool_id"),
+		"service_types":     cty.GetAttrPath("service_types"),
+		"prefixlen":         cty.GetAttrPath("prefix_length"),
+		"allocation_pools":  cty.GetAttrPath("allocation_pool"),
+	}
+}
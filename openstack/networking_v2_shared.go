@@ -33,49 +33,46 @@ type neutronError struct {
 }
 
 func retryOn409(err error) bool {
-	var e gophercloud.ErrUnexpectedResponseCode
-
-	ok := errors.As(err, &e)
-	if !ok {
+	switch classifyOpenStackError(err) {
+	case openStackErrorCapacity:
+		return true
+	case openStackErrorConflict:
+		// don't retry on other conflicts
+		return !isNeutronError(err)
+	case openStackErrorQuota:
+		// don't retry on quota errors
 		return false
 	}
 
-	switch e.Actual {
-	case http.StatusConflict: // 409
-		neutronError, err := decodeNeutronError(e.Body)
-		if err != nil {
-			// retry, when error type cannot be detected
-			log.Printf("[DEBUG] failed to decode a neutron error: %s", err)
-
-			return true
-		}
+	switch {
+	case gophercloud.ResponseCodeIs(err, http.StatusBadRequest):
+		// don't retry on other input errors
+		return !isNeutronError(err)
+	case gophercloud.ResponseCodeIs(err, http.StatusNotFound):
+		// this case is handled mostly for functional tests
+		return true
+	}
 
-		if neutronError.Type == "IpAddressGenerationFailure" {
-			return true
-		}
+	return false
+}
 
-		// don't retry on quota or other errors
+// isNeutronError reports whether the body of the error response is a
+// Neutron error. Responses of proxies or load balancers in front of Neutron
+// can't be decoded and are retried.
+func isNeutronError(err error) bool {
+	var e gophercloud.ErrUnexpectedResponseCode
+	if !errors.As(err, &e) {
 		return false
-	case http.StatusBadRequest: // 400
-		neutronError, err := decodeNeutronError(e.Body)
-		if err != nil {
-			// retry, when error type cannot be detected
-			log.Printf("[DEBUG] failed to decode a neutron error: %s", err)
-
-			return true
-		}
+	}
 
-		if neutronError.Type == "ExternalIpAddressExhausted" {
-			return true
-		}
+	if _, err := decodeNeutronError(e.Body); err != nil {
+		// retry, when error type cannot be detected
+		log.Printf("[DEBUG] failed to decode a neutron error: %s", err)
 
-		// don't retry on quota or other errors
 		return false
-	case http.StatusNotFound: // this case is handled mostly for functional tests
-		return true
 	}
 
-	return false
+	return true
 }
 
 func decodeNeutronError(body []byte) (*neutronError, error) {
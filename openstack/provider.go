@@ -4,11 +4,14 @@ import (
 	"context"
 	"os"
 	"runtime/debug"
+	"time"
 
 	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-provider-openstack/utils/v2/auth"
 	"github.com/terraform-provider-openstack/utils/v2/mutexkv"
 )
@@ -19,6 +22,20 @@ var version = "dev"
 // Config struct.
 type Config struct {
 	auth.Config
+
+	endpoints          *serviceEndpoints
+	rateLimiter        *rateLimiter
+	lookupCache        *lookupCache
+	scopes             map[string]*providerScope
+	retryOnNoValidHost int
+
+	// federatedToken obtains a new unscoped token from the identity
+	// provider, when the provider authenticates with OpenID Connect.
+	federatedToken func(ctx context.Context) (string, error)
+
+	// federatedAuth delays the authentication with a federated token
+	// until a service client is needed.
+	federatedAuth *providerFederatedAuth
 }
 
 // Provider returns a schema.Provider for OpenStack.
@@ -40,6 +57,30 @@ func Provider() *schema.Provider {
 
 		"application_credential_secret": "Application Credential secret to login with.",
 
+		"auth_type": "The authentication type to use. Set to `v3oidcpassword` or `v3oidcaccesstoken`\n" +
+			"to login with an OpenID Connect identity provider, or to `v3oauth2clientcredential`\n" +
+			"to login with an application credential using OAuth2 (Identity v3).",
+
+		"trust_id": "The ID of a trust to scope to (Identity v3).",
+
+		"identity_provider": "The name of the federated identity provider to login with (Identity v3).",
+
+		"protocol": "The federation protocol of the identity provider to login with (Identity v3).",
+
+		"access_token": "An OpenID Connect access token to login with, when `auth_type` is `v3oidcaccesstoken`.",
+
+		"access_token_endpoint": "The OpenID Connect token endpoint, when `auth_type` is `v3oidcpassword`,\n" +
+			"or the OAuth2 token endpoint, when `auth_type` is `v3oauth2clientcredential`.",
+
+		"discovery_endpoint": "The OpenID Connect discovery document URL, used to find the token\n" +
+			"endpoint when `access_token_endpoint` is not set.",
+
+		"client_id": "The OpenID Connect client ID, when `auth_type` is `v3oidcpassword`.",
+
+		"client_secret": "The OpenID Connect client secret, when `auth_type` is `v3oidcpassword`.",
+
+		"openid_scope": "The OpenID Connect scope to request. Defaults to `openid`.",
+
 		"tenant_id": "The ID of the Tenant (Identity v2) or Project (Identity v3)\n" +
 			"to login with.",
 
@@ -92,6 +133,14 @@ func Provider() *schema.Provider {
 
 		"max_retries": "How many times HTTP connection should be retried until giving up.",
 
+		"rate_limit": "Limits the rate and the concurrency of the requests sent to an OpenStack service.",
+
+		"retry_on_no_valid_host": "How many times a compute instance, which failed to be scheduled, is deleted and created again.",
+
+		"scope": "Named project scopes, which can be selected by the resources and data sources with the provider_scope argument.",
+
+		"lookup_cache_ttl": "How many seconds the name to ID lookups of flavors, images, networks and security groups are cached. Defaults to 0, which disables the cache.",
+
 		"enable_logging": "Outputs very verbose logs with all calls made to and responses from OpenStack",
 	}
 
@@ -146,6 +195,78 @@ func Provider() *schema.Provider {
 				Description: descriptions["application_credential_secret"],
 			},
 
+			"auth_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_AUTH_TYPE", ""),
+				Description: descriptions["auth_type"],
+			},
+
+			"trust_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_TRUST_ID", ""),
+				Description: descriptions["trust_id"],
+			},
+
+			"identity_provider": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_IDENTITY_PROVIDER", ""),
+				Description: descriptions["identity_provider"],
+			},
+
+			"protocol": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_PROTOCOL", ""),
+				Description: descriptions["protocol"],
+			},
+
+			"access_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_ACCESS_TOKEN", ""),
+				Description: descriptions["access_token"],
+			},
+
+			"access_token_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_ACCESS_TOKEN_ENDPOINT", ""),
+				Description: descriptions["access_token_endpoint"],
+			},
+
+			"discovery_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_DISCOVERY_ENDPOINT", ""),
+				Description: descriptions["discovery_endpoint"],
+			},
+
+			"client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_CLIENT_ID", ""),
+				Description: descriptions["client_id"],
+			},
+
+			"client_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_CLIENT_SECRET", ""),
+				Description: descriptions["client_secret"],
+			},
+
+			"openid_scope": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_OPENID_SCOPE", ""),
+				Description: descriptions["openid_scope"],
+			},
+
 			"tenant_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -309,6 +430,83 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"rate_limit": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: descriptions["rate_limit"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+						},
+
+						"max_concurrent_requests": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
+			"retry_on_no_valid_host": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  descriptions["retry_on_no_valid_host"],
+			},
+
+			"lookup_cache_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  descriptions["lookup_cache_ttl"],
+			},
+
+			"scope": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: descriptions["scope"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"project_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"project_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"project_domain_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"project_domain_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
 			"endpoint_overrides": {
 				Type:        schema.TypeMap,
 				Optional:    true,
@@ -347,6 +545,9 @@ func Provider() *schema.Provider {
 			"openstack_containerinfra_nodegroup_v1":              dataSourceContainerInfraNodeGroupV1(),
 			"openstack_containerinfra_clustertemplate_v1":        dataSourceContainerInfraClusterTemplateV1(),
 			"openstack_containerinfra_cluster_v1":                dataSourceContainerInfraCluster(),
+			"openstack_db_datastore_v1":                          dataSourceDatabaseDatastoreV1(),
+			"openstack_db_flavor_v1":                             dataSourceDatabaseFlavorV1(),
+			"openstack_db_configuration_parameters_v1":           dataSourceDatabaseConfigurationParametersV1(),
 			"openstack_dns_zone_v2":                              dataSourceDNSZoneV2(),
 			"openstack_dns_zone_share_v2":                        dataSourceDNSZoneShareV2(),
 			"openstack_fw_group_v2":                              dataSourceFWGroupV2(),
@@ -360,10 +561,13 @@ func Provider() *schema.Provider {
 			"openstack_identity_endpoint_v3":                     dataSourceIdentityEndpointV3(),
 			"openstack_identity_service_v3":                      dataSourceIdentityServiceV3(),
 			"openstack_identity_group_v3":                        dataSourceIdentityGroupV3(),
+			"openstack_identity_domain_v3":                       dataSourceIdentityDomainV3(),
+			"openstack_identity_role_assignments_v3":             dataSourceIdentityRoleAssignmentsV3(),
 			"openstack_images_image_v2":                          dataSourceImagesImageV2(),
 			"openstack_images_image_ids_v2":                      dataSourceImagesImageIDsV2(),
 			"openstack_networking_addressscope_v2":               dataSourceNetworkingAddressScopeV2(),
 			"openstack_networking_network_v2":                    dataSourceNetworkingNetworkV2(),
+			"openstack_networking_network_ip_availability_v2":    dataSourceNetworkingNetworkIPAvailabilityV2(),
 			"openstack_networking_qos_bandwidth_limit_rule_v2":   dataSourceNetworkingQoSBandwidthLimitRuleV2(),
 			"openstack_networking_qos_dscp_marking_rule_v2":      dataSourceNetworkingQoSDSCPMarkingRuleV2(),
 			"openstack_networking_qos_minimum_bandwidth_rule_v2": dataSourceNetworkingQoSMinimumBandwidthRuleV2(),
@@ -379,6 +583,9 @@ func Provider() *schema.Provider {
 			"openstack_networking_port_ids_v2":                   dataSourceNetworkingPortIDsV2(),
 			"openstack_networking_trunk_v2":                      dataSourceNetworkingTrunkV2(),
 			"openstack_networking_segment_v2":                    dataSourceNetworkingSegmentV2(),
+			"openstack_networking_local_ip_v2":                   dataSourceNetworkingLocalIPV2(),
+			"openstack_networking_local_ip_association_v2":       dataSourceNetworkingLocalIPAssociationV2(),
+			"openstack_networking_ndp_proxy_v2":                  dataSourceNetworkingNDPProxyV2(),
 			"openstack_sharedfilesystem_availability_zones_v2":   dataSourceSharedFilesystemAvailabilityZonesV2(),
 			"openstack_sharedfilesystem_sharenetwork_v2":         dataSourceSharedFilesystemShareNetworkV2(),
 			"openstack_sharedfilesystem_share_v2":                dataSourceSharedFilesystemShareV2(),
@@ -388,6 +595,11 @@ func Provider() *schema.Provider {
 			"openstack_loadbalancer_flavor_v2":                   dataSourceLoadBalancerFlavorV2(),
 			"openstack_lb_flavor_v2":                             dataSourceLBFlavorV2(),
 			"openstack_lb_flavorprofile_v2":                      dataSourceLBFlavorProfileV2(),
+			"openstack_lb_availability_zone_v2":                  dataSourceLBAvailabilityZoneV2(),
+			"openstack_lb_availability_zone_profile_v2":          dataSourceLBAvailabilityZoneProfileV2(),
+			"openstack_lb_amphorae_v2":                           dataSourceLBAmphoraeV2(),
+			"openstack_lb_loadbalancer_status_v2":                dataSourceLBLoadbalancerStatusV2(),
+			"openstack_lb_stats_v2":                              dataSourceLBStatsV2(),
 			"openstack_lb_loadbalancer_v2":                       dataSourceLBLoadbalancerV2(),
 			"openstack_lb_listener_v2":                           dataSourceLBListenerV2(),
 			"openstack_lb_pool_v2":                               dataSourceLBPoolV2(),
@@ -418,6 +630,7 @@ func Provider() *schema.Provider {
 			"openstack_db_user_v1":                               resourceDatabaseUserV1(),
 			"openstack_db_configuration_v1":                      resourceDatabaseConfigurationV1(),
 			"openstack_db_database_v1":                           resourceDatabaseDatabaseV1(),
+			"openstack_db_backup_v1":                             resourceDatabaseBackupV1(),
 			"openstack_dns_recordset_v2":                         resourceDNSRecordSetV2(),
 			"openstack_dns_zone_v2":                              resourceDNSZoneV2(),
 			"openstack_dns_zone_share_v2":                        resourceDNSZoneShareV2(),
@@ -440,11 +653,21 @@ func Provider() *schema.Provider {
 			"openstack_identity_ec2_credential_v3":               resourceIdentityEc2CredentialV3(),
 			"openstack_identity_registered_limit_v3":             resourceIdentityRegisteredLimitV3(),
 			"openstack_identity_limit_v3":                        resourceIdentityLimitV3(),
+			"openstack_identity_domain_v3":                       resourceIdentityDomainV3(),
+			"openstack_identity_domain_config_v3":                resourceIdentityDomainConfigV3(),
+			"openstack_identity_provider_v3":                     resourceIdentityProviderV3(),
+			"openstack_identity_mapping_v3":                      resourceIdentityMappingV3(),
+			"openstack_identity_protocol_v3":                     resourceIdentityProtocolV3(),
+			"openstack_identity_trust_v3":                        resourceIdentityTrustV3(),
+			"openstack_identity_project_role_assignments_v3":     resourceIdentityProjectRoleAssignmentsV3(),
 			"openstack_images_image_v2":                          resourceImagesImageV2(),
 			"openstack_images_image_access_v2":                   resourceImagesImageAccessV2(),
 			"openstack_images_image_access_accept_v2":            resourceImagesImageAccessAcceptV2(),
 			"openstack_lb_flavor_v2":                             resourceLoadBalancerFlavorV2(),
 			"openstack_lb_flavorprofile_v2":                      resourceLoadBalancerFlavorProfileV2(),
+			"openstack_lb_availability_zone_v2":                  resourceLoadBalancerAvailabilityZoneV2(),
+			"openstack_lb_availability_zone_profile_v2":          resourceLoadBalancerAvailabilityZoneProfileV2(),
+			"openstack_lb_amphora_action_v2":                     resourceLoadBalancerAmphoraActionV2(),
 			"openstack_lb_loadbalancer_v2":                       resourceLoadBalancerV2(),
 			"openstack_lb_listener_v2":                           resourceListenerV2(),
 			"openstack_lb_pool_v2":                               resourcePoolV2(),
@@ -471,6 +694,7 @@ func Provider() *schema.Provider {
 			"openstack_networking_router_interface_v2":           resourceNetworkingRouterInterfaceV2(),
 			"openstack_networking_router_route_v2":               resourceNetworkingRouterRouteV2(),
 			"openstack_networking_router_routes_v2":              resourceNetworkingRouterRoutesV2(),
+			"openstack_networking_router_conntrack_helper_v2":    resourceNetworkingRouterConntrackHelperV2(),
 			"openstack_networking_secgroup_v2":                   resourceNetworkingSecGroupV2(),
 			"openstack_networking_secgroup_rule_v2":              resourceNetworkingSecGroupRuleV2(),
 			"openstack_networking_address_group_v2":              resourceNetworkingAddressGroupV2(),
@@ -481,6 +705,11 @@ func Provider() *schema.Provider {
 			"openstack_networking_trunk_v2":                      resourceNetworkingTrunkV2(),
 			"openstack_networking_portforwarding_v2":             resourceNetworkingPortForwardingV2(),
 			"openstack_networking_segment_v2":                    resourceNetworkingSegmentV2(),
+			"openstack_networking_metering_label_v2":             resourceNetworkingMeteringLabelV2(),
+			"openstack_networking_metering_label_rule_v2":        resourceNetworkingMeteringLabelRuleV2(),
+			"openstack_networking_local_ip_v2":                   resourceNetworkingLocalIPV2(),
+			"openstack_networking_local_ip_association_v2":       resourceNetworkingLocalIPAssociationV2(),
+			"openstack_networking_ndp_proxy_v2":                  resourceNetworkingNDPProxyV2(),
 			"openstack_objectstorage_account_v1":                 resourceObjectStorageAccountV1(),
 			"openstack_objectstorage_container_v1":               resourceObjectStorageContainerV1(),
 			"openstack_objectstorage_object_v1":                  resourceObjectStorageObjectV1(),
@@ -496,6 +725,9 @@ func Provider() *schema.Provider {
 			"openstack_sharedfilesystem_sharenetwork_v2":         resourceSharedFilesystemShareNetworkV2(),
 			"openstack_sharedfilesystem_share_v2":                resourceSharedFilesystemShareV2(),
 			"openstack_sharedfilesystem_share_access_v2":         resourceSharedFilesystemShareAccessV2(),
+			"openstack_sharedfilesystem_share_replica_v2":        resourceSharedFilesystemShareReplicaV2(),
+			"openstack_sharedfilesystem_share_type_v2":           resourceSharedFilesystemShareTypeV2(),
+			"openstack_sharedfilesystem_snapshot_v2":             resourceSharedFilesystemSnapshotV2(),
 			"openstack_keymanager_secret_v1":                     resourceKeyManagerSecretV1(),
 			"openstack_keymanager_container_v1":                  resourceKeyManagerContainerV1(),
 			"openstack_keymanager_order_v1":                      resourceKeyManagerOrderV1(),
@@ -506,6 +738,14 @@ func Provider() *schema.Provider {
 		},
 	}
 
+	for _, r := range provider.DataSourcesMap {
+		addProviderScopeSchema(r, false)
+	}
+
+	for _, r := range provider.ResourcesMap {
+		addProviderScopeSchema(r, true)
+	}
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
 		return configureProvider(ctx, provider, d)
 	}
@@ -556,7 +796,7 @@ func configureProvider(ctx context.Context, provider *schema.Provider, d *schema
 	}
 
 	config := Config{
-		auth.Config{
+		Config: auth.Config{
 			CACertFile:                  d.Get("cacert_file").(string),
 			ClientCertFile:              d.Get("cert").(string),
 			ClientKeyFile:               d.Get("key").(string),
@@ -593,6 +833,9 @@ func configureProvider(ctx context.Context, provider *schema.Provider, d *schema
 			MutexKV:                     mutexkv.NewMutexKV(),
 			EnableLogger:                enableLogging,
 		},
+		endpoints:          newServiceEndpoints(),
+		lookupCache:        newLookupCache(time.Duration(d.Get("lookup_cache_ttl").(int)) * time.Second),
+		retryOnNoValidHost: d.Get("retry_on_no_valid_host").(int),
 	}
 
 	v, ok := getOkExists(d, "insecure")
@@ -601,9 +844,58 @@ func configureProvider(ctx context.Context, provider *schema.Provider, d *schema
 		config.Insecure = &insecure
 	}
 
+	rateLimiter, err := expandProviderRateLimiter(&config, d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	config.rateLimiter = rateLimiter
+
+	if err := configureProviderAuthType(ctx, &config, d); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	// The authentication is delayed until the logging and the rate limiting
+	// of the provider client are configured.
+	delayedAuth := config.DelayedAuth
+	config.DelayedAuth = true
+
 	if err := config.LoadAndValidate(ctx); err != nil {
 		return nil, diag.FromErr(err)
 	}
 
+	configureProviderLogging(&config)
+	configureProviderRateLimit(&config)
+
+	if err := configureProviderAuthOptions(ctx, &config, d); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	config.DelayedAuth = delayedAuth
+
+	switch {
+	case config.federatedAuth != nil:
+		// The federated token is obtained by the provider itself, since
+		// auth.Config would authenticate without it.
+		config.DelayedAuth = false
+
+		if !delayedAuth {
+			if err := config.authenticate(ctx); err != nil {
+				return nil, diag.FromErr(err)
+			}
+		}
+	case !config.DelayedAuth && !config.Swauth:
+		if err := openstack.Authenticate(ctx, config.OsClient, *config.AuthOpts); err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
+	scopes, err := expandProviderScopes(d.Get("scope").([]any))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	config.scopes = scopes
+
 	return &config, nil
 }
@@ -0,0 +1,529 @@
+package openstack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-provider-openstack/utils/v2/auth"
+)
+
+const (
+	authTypeV3OIDCPassword    = "v3oidcpassword"
+	authTypeV3OIDCAccessToken = "v3oidcaccesstoken"
+
+	authTypeV3OAuth2ClientCredential = "v3oauth2clientcredential"
+)
+
+// v3OIDCAuthOptions holds the options needed to authenticate with Keystone
+// using an OpenID Connect access token.
+type v3OIDCAuthOptions struct {
+	IdentityProvider    string
+	Protocol            string
+	AccessToken         string
+	AccessTokenEndpoint string
+	DiscoveryEndpoint   string
+	ClientID            string
+	ClientSecret        string
+	Username            string
+	Password            string
+	Scope               string
+}
+
+// configureProviderAuthType handles the authentication types which are not
+// supported by auth.Config. Each of them results in a Keystone token, which
+// is then used to authenticate the provider.
+func configureProviderAuthType(ctx context.Context, config *Config, d *schema.ResourceData) error {
+	authType := d.Get("auth_type").(string)
+
+	switch authType {
+	case authTypeV3OIDCPassword, authTypeV3OIDCAccessToken:
+	default:
+		return nil
+	}
+
+	if config.IdentityEndpoint == "" {
+		return fmt.Errorf("'auth_url' must be specified when 'auth_type' is %s", authType)
+	}
+
+	opts := v3OIDCAuthOptions{
+		IdentityProvider:    d.Get("identity_provider").(string),
+		Protocol:            d.Get("protocol").(string),
+		AccessToken:         d.Get("access_token").(string),
+		AccessTokenEndpoint: d.Get("access_token_endpoint").(string),
+		DiscoveryEndpoint:   d.Get("discovery_endpoint").(string),
+		ClientID:            d.Get("client_id").(string),
+		ClientSecret:        d.Get("client_secret").(string),
+		Username:            config.Username,
+		Password:            config.Password,
+		Scope:               d.Get("openid_scope").(string),
+	}
+
+	if opts.IdentityProvider == "" || opts.Protocol == "" {
+		return fmt.Errorf("'identity_provider' and 'protocol' must be specified when 'auth_type' is %s", authType)
+	}
+
+	identityEndpoint := config.IdentityEndpoint
+
+	// The exchange is run by the reauthentication function of the provider
+	// client, since the federated token can't be renewed by rescoping it.
+	// The requests are sent by the HTTP client of the provider, so they are
+	// logged and rate limited like the other requests.
+	federatedToken := func(ctx context.Context) (string, error) {
+		opts := opts
+		httpClient := &config.OsClient.HTTPClient
+
+		if authType == authTypeV3OIDCPassword {
+			var err error
+
+			opts.AccessToken, err = v3OIDCPasswordAccessToken(ctx, httpClient, opts)
+			if err != nil {
+				return "", err
+			}
+		}
+
+		if opts.AccessToken == "" {
+			return "", fmt.Errorf("'access_token' must be specified when 'auth_type' is %s", authType)
+		}
+
+		return v3OIDCKeystoneToken(ctx, httpClient, identityEndpoint, opts)
+	}
+
+	// The unscoped federated token is rescoped by the regular token
+	// authentication, using the configured project or domain.
+	config.Token = ""
+	config.Username = ""
+	config.UserID = ""
+	config.Password = ""
+	config.ApplicationCredentialID = ""
+	config.ApplicationCredentialName = ""
+	config.ApplicationCredentialSecret = ""
+	config.federatedToken = federatedToken
+
+	return nil
+}
+
+// configureProviderAuthOptions adjusts the authentication options built by
+// auth.Config.LoadAndValidate for the trust scope, the OAuth2 client
+// credentials authentication type and the reauthentication of federated
+// tokens.
+func configureProviderAuthOptions(ctx context.Context, config *Config, d *schema.ResourceData) error {
+	trustID := d.Get("trust_id").(string)
+	oauth2 := d.Get("auth_type").(string) == authTypeV3OAuth2ClientCredential
+
+	if trustID == "" && !oauth2 {
+		if config.federatedToken != nil {
+			if config.Swauth || config.AuthOpts == nil {
+				return errors.New("OpenID Connect can only be used with Keystone authentication")
+			}
+
+			configureProviderFederatedReauth(config)
+		}
+
+		return nil
+	}
+
+	if config.Swauth || config.AuthOpts == nil {
+		return errors.New("'trust_id' and OAuth2 client credentials can only be used with Keystone authentication")
+	}
+
+	if trustID != "" {
+		if oauth2 {
+			return fmt.Errorf("'trust_id' cannot be used when 'auth_type' is %s", authTypeV3OAuth2ClientCredential)
+		}
+
+		if config.AuthOpts.ApplicationCredentialID != "" || config.AuthOpts.ApplicationCredentialName != "" {
+			return errors.New("'trust_id' cannot be used with application credentials")
+		}
+
+		// A trust scoped token must not request any other scope.
+		config.AuthOpts.Scope = &gophercloud.AuthScope{TrustID: trustID}
+		config.AuthOpts.TenantID = ""
+		config.AuthOpts.TenantName = ""
+	}
+
+	if oauth2 {
+		if err := configureProviderOAuth2ClientCredential(ctx, config, d.Get("access_token_endpoint").(string)); err != nil {
+			return err
+		}
+	}
+
+	if config.federatedToken != nil {
+		configureProviderFederatedReauth(config)
+	}
+
+	return nil
+}
+
+// configureProviderFederatedReauth replaces the reauthentication of
+// gophercloud, which would rescope the expired federated token, with one
+// that obtains a new federated token and rescopes that one. The provider
+// client is initially authenticated with it as well, see authenticate.
+func configureProviderFederatedReauth(config *Config) {
+	config.AuthOpts.AllowReauth = false
+	config.federatedAuth = &providerFederatedAuth{}
+
+	client := config.OsClient
+	authOpts := *config.AuthOpts
+
+	// The catalog is read from the authentication result, which is copied
+	// from the throwaway client.
+	client.EndpointLocator = config.EndpointLocator
+
+	client.ReauthFunc = func(ctx context.Context) error {
+		token, err := config.federatedToken(ctx)
+		if err != nil {
+			return err
+		}
+
+		tac, err := openstack.NewClient(authOpts.IdentityEndpoint)
+		if err != nil {
+			return err
+		}
+
+		tac.HTTPClient = client.HTTPClient
+		tac.UserAgent = client.UserAgent
+		tac.SetThrowaway(true)
+
+		opts := authOpts
+		opts.TokenID = token
+
+		if err := openstack.Authenticate(ctx, tac, opts); err != nil {
+			return err
+		}
+
+		client.CopyTokenFrom(tac)
+
+		return nil
+	}
+}
+
+// providerFederatedAuth tracks the initial authentication of a provider
+// client, which authenticates with a federated token.
+type providerFederatedAuth struct {
+	mu            sync.Mutex
+	authenticated bool
+	err           error
+}
+
+// authenticate authenticates the provider client. A federated token is only
+// obtained on the first call, which is delayed until a service client is
+// needed, when delayed_auth is set. Other clients are authenticated by
+// auth.Config.
+func (c *Config) authenticate(ctx context.Context) error {
+	if c.federatedAuth == nil {
+		return c.Authenticate(ctx)
+	}
+
+	a := c.federatedAuth
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.authenticated || a.err != nil {
+		return a.err
+	}
+
+	if err := c.OsClient.Reauthenticate(ctx, ""); err != nil {
+		a.err = err
+
+		return err
+	}
+
+	if !c.AllowReauth {
+		c.OsClient.ReauthFunc = nil
+	}
+
+	a.authenticated = true
+
+	return nil
+}
+
+// configureProviderOAuth2ClientCredential obtains a Keystone token with the
+// OAuth2 client credentials grant, using an application credential as the
+// client. The token is passed through as is, since Keystone does not allow
+// rescoping it, and a new one is obtained on reauthentication.
+func configureProviderOAuth2ClientCredential(ctx context.Context, config *Config, tokenEndpoint string) error {
+	clientID := config.AuthOpts.ApplicationCredentialID
+	clientSecret := config.AuthOpts.ApplicationCredentialSecret
+
+	if clientID == "" || clientSecret == "" {
+		return fmt.Errorf("'application_credential_id' and 'application_credential_secret' must be specified when 'auth_type' is %s",
+			authTypeV3OAuth2ClientCredential)
+	}
+
+	if tokenEndpoint == "" {
+		tokenEndpoint = providerAuthV3URL(config.AuthOpts.IdentityEndpoint, "OS-OAUTH2", "token")
+	}
+
+	httpClient, err := newProviderAuthHTTPClient(config)
+	if err != nil {
+		return err
+	}
+
+	token, err := v3OAuth2ClientCredentialToken(ctx, httpClient, tokenEndpoint, clientID, clientSecret)
+	if err != nil {
+		return err
+	}
+
+	config.AuthOpts.TokenID = token
+	config.AuthOpts.Scope = &gophercloud.AuthScope{}
+	config.AuthOpts.AllowReauth = false
+	config.AuthOpts.Username = ""
+	config.AuthOpts.UserID = ""
+	config.AuthOpts.Password = ""
+	config.AuthOpts.TenantID = ""
+	config.AuthOpts.TenantName = ""
+	config.AuthOpts.DomainID = ""
+	config.AuthOpts.DomainName = ""
+	config.AuthOpts.ApplicationCredentialID = ""
+	config.AuthOpts.ApplicationCredentialName = ""
+	config.AuthOpts.ApplicationCredentialSecret = ""
+
+	if config.AllowReauth {
+		client := config.OsClient
+		client.ReauthFunc = func(ctx context.Context) error {
+			token, err := v3OAuth2ClientCredentialToken(ctx, httpClient, tokenEndpoint, clientID, clientSecret)
+			if err != nil {
+				return err
+			}
+
+			client.SetToken(token)
+
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// v3OAuth2ClientCredentialToken obtains a Keystone token from the Keystone
+// OAuth2 token endpoint using the client credentials grant.
+func v3OAuth2ClientCredentialToken(ctx context.Context, client *http.Client, tokenEndpoint, clientID, clientSecret string) (string, error) {
+	form := url.Values{
+		"grant_type": {"client_credentials"},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(clientID, clientSecret)
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+
+	if err := doProviderAuthJSONRequest(client, req, &token); err != nil {
+		return "", fmt.Errorf("Error obtaining OAuth2 access token: %w", err)
+	}
+
+	if token.AccessToken == "" {
+		return "", errors.New("OAuth2 token response does not contain an access_token")
+	}
+
+	log.Printf("[DEBUG] Obtained an OAuth2 access token for application credential %s", clientID)
+
+	return token.AccessToken, nil
+}
+
+// newProviderAuthHTTPClient returns an HTTP client which honours the TLS
+// settings of the provider.
+func newProviderAuthHTTPClient(config *Config) (*http.Client, error) {
+	tlsConfig, err := auth.PrepareTLSConfig(config.CACertFile, config.ClientCertFile, config.ClientKeyFile, config.Insecure)
+	if err != nil {
+		return nil, err
+	}
+
+	var rt http.RoundTripper = &http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: tlsConfig,
+	}
+
+	// The requests to the identity provider are logged and rate limited
+	// like the requests of the provider client.
+	if config.EnableLogger || os.Getenv("OS_DEBUG") != "" {
+		rt = &loggingTransport{
+			rt:        rt,
+			endpoints: config.endpoints,
+		}
+	}
+
+	if config.rateLimiter != nil {
+		rt = config.rateLimiter.transport(rt)
+	}
+
+	return &http.Client{Transport: rt}, nil
+}
+
+// v3OIDCDiscoverTokenEndpoint reads the token endpoint from the OpenID
+// Connect discovery document.
+func v3OIDCDiscoverTokenEndpoint(ctx context.Context, client *http.Client, discoveryEndpoint string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryEndpoint, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var discovery struct {
+		TokenEndpoint string `json:"token_endpoint"`
+	}
+
+	if err := doProviderAuthJSONRequest(client, req, &discovery); err != nil {
+		return "", fmt.Errorf("Error retrieving OpenID Connect discovery document: %w", err)
+	}
+
+	if discovery.TokenEndpoint == "" {
+		return "", errors.New("OpenID Connect discovery document does not contain a token_endpoint")
+	}
+
+	return discovery.TokenEndpoint, nil
+}
+
+// v3OIDCPasswordAccessToken obtains an access token from the OpenID Connect
+// provider using the resource owner password credentials grant.
+func v3OIDCPasswordAccessToken(ctx context.Context, client *http.Client, opts v3OIDCAuthOptions) (string, error) {
+	if opts.Username == "" || opts.Password == "" {
+		return "", fmt.Errorf("'user_name' and 'password' must be specified when 'auth_type' is %s", authTypeV3OIDCPassword)
+	}
+
+	tokenEndpoint := opts.AccessTokenEndpoint
+	if tokenEndpoint == "" {
+		if opts.DiscoveryEndpoint == "" {
+			return "", fmt.Errorf("One of 'access_token_endpoint' or 'discovery_endpoint' must be specified when 'auth_type' is %s", authTypeV3OIDCPassword)
+		}
+
+		var err error
+
+		tokenEndpoint, err = v3OIDCDiscoverTokenEndpoint(ctx, client, opts.DiscoveryEndpoint)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	scope := opts.Scope
+	if scope == "" {
+		scope = "openid"
+	}
+
+	form := url.Values{
+		"grant_type": {"password"},
+		"username":   {opts.Username},
+		"password":   {opts.Password},
+		"scope":      {scope},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(opts.ClientID, opts.ClientSecret)
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+
+	if err := doProviderAuthJSONRequest(client, req, &token); err != nil {
+		return "", fmt.Errorf("Error obtaining OpenID Connect access token: %w", err)
+	}
+
+	if token.AccessToken == "" {
+		return "", errors.New("OpenID Connect token response does not contain an access_token")
+	}
+
+	return token.AccessToken, nil
+}
+
+// v3OIDCKeystoneToken exchanges an OpenID Connect access token for an
+// unscoped Keystone token.
+func v3OIDCKeystoneToken(ctx context.Context, client *http.Client, authURL string, opts v3OIDCAuthOptions) (string, error) {
+	endpoint := providerAuthV3URL(authURL, "OS-FEDERATION", "identity_providers",
+		opts.IdentityProvider, "protocols", opts.Protocol, "auth")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+opts.AccessToken)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("Error authenticating with identity provider %s: %w", opts.IdentityProvider, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+
+		return "", fmt.Errorf("Error authenticating with identity provider %s: %w", opts.IdentityProvider,
+			gophercloud.ErrUnexpectedResponseCode{
+				URL:      endpoint,
+				Method:   http.MethodPost,
+				Expected: []int{http.StatusCreated},
+				Actual:   resp.StatusCode,
+				Body:     body,
+			})
+	}
+
+	token := resp.Header.Get("X-Subject-Token")
+	if token == "" {
+		return "", fmt.Errorf("Identity provider %s authentication did not return a token", opts.IdentityProvider)
+	}
+
+	log.Printf("[DEBUG] Obtained a federated token using identity provider %s and protocol %s", opts.IdentityProvider, opts.Protocol)
+
+	return token, nil
+}
+
+// providerAuthV3URL builds an Identity v3 URL from the auth_url, which may
+// or may not contain the version suffix.
+func providerAuthV3URL(authURL string, parts ...string) string {
+	base := strings.TrimSuffix(authURL, "/")
+	if !strings.HasSuffix(base, "/v3") {
+		base += "/v3"
+	}
+
+	return base + "/" + strings.Join(parts, "/")
+}
+
+func doProviderAuthJSONRequest(client *http.Client, req *http.Request, v any) error {
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return gophercloud.ErrUnexpectedResponseCode{
+			URL:      req.URL.String(),
+			Method:   req.Method,
+			Expected: []int{http.StatusOK},
+			Actual:   resp.StatusCode,
+			Body:     body,
+		}
+	}
+
+	return json.Unmarshal(body, v)
+}
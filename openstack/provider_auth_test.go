@@ -0,0 +1,319 @@
+package openstack
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack"
+	osClient "github.com/gophercloud/utils/v2/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/terraform-provider-openstack/utils/v2/auth"
+)
+
+func TestUnitProviderAuthV3URL(t *testing.T) {
+	expected := "https://keystone.example.com/v3/OS-FEDERATION/identity_providers/idp/protocols/openid/auth"
+
+	for _, authURL := range []string{
+		"https://keystone.example.com",
+		"https://keystone.example.com/",
+		"https://keystone.example.com/v3",
+		"https://keystone.example.com/v3/",
+	} {
+		actual := providerAuthV3URL(authURL, "OS-FEDERATION", "identity_providers", "idp", "protocols", "openid", "auth")
+		assert.Equal(t, expected, actual)
+	}
+}
+
+func TestUnitV3OIDCPasswordAccessToken(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"token_endpoint": "` + server.URL + `/token"}`))
+	})
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "password", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "alice", r.PostForm.Get("username"))
+		assert.Equal(t, "secret", r.PostForm.Get("password"))
+		assert.Equal(t, "openid", r.PostForm.Get("scope"))
+
+		clientID, clientSecret, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "keystone", clientID)
+		assert.Equal(t, "client-secret", clientSecret)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "oidc-token", "token_type": "Bearer"}`))
+	})
+
+	opts := v3OIDCAuthOptions{
+		DiscoveryEndpoint: server.URL + "/.well-known/openid-configuration",
+		ClientID:          "keystone",
+		ClientSecret:      "client-secret",
+		Username:          "alice",
+		Password:          "secret",
+	}
+
+	token, err := v3OIDCPasswordAccessToken(t.Context(), server.Client(), opts)
+	require.NoError(t, err)
+	assert.Equal(t, "oidc-token", token)
+}
+
+func TestUnitV3OIDCPasswordAccessTokenMissingEndpoint(t *testing.T) {
+	opts := v3OIDCAuthOptions{
+		Username: "alice",
+		Password: "secret",
+	}
+
+	_, err := v3OIDCPasswordAccessToken(t.Context(), http.DefaultClient, opts)
+	require.Error(t, err)
+}
+
+func TestUnitV3OIDCKeystoneToken(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/v3/OS-FEDERATION/identity_providers/idp/protocols/openid/auth", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "Bearer oidc-token", r.Header.Get("Authorization"))
+
+		w.Header().Set("X-Subject-Token", "keystone-token")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	opts := v3OIDCAuthOptions{
+		IdentityProvider: "idp",
+		Protocol:         "openid",
+		AccessToken:      "oidc-token",
+	}
+
+	token, err := v3OIDCKeystoneToken(t.Context(), server.Client(), server.URL+"/v3", opts)
+	require.NoError(t, err)
+	assert.Equal(t, "keystone-token", token)
+}
+
+func TestUnitV3OIDCKeystoneTokenUnauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	opts := v3OIDCAuthOptions{
+		IdentityProvider: "idp",
+		Protocol:         "openid",
+		AccessToken:      "oidc-token",
+	}
+
+	_, err := v3OIDCKeystoneToken(t.Context(), server.Client(), server.URL, opts)
+	require.Error(t, err)
+}
+
+func TestUnitV3OAuth2ClientCredentialToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v3/OS-OAUTH2/token", r.URL.Path)
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+
+		clientID, clientSecret, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "app-cred-id", clientID)
+		assert.Equal(t, "app-cred-secret", clientSecret)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "keystone-token", "token_type": "Bearer", "expires_in": 3600}`))
+	}))
+	defer server.Close()
+
+	endpoint := providerAuthV3URL(server.URL, "OS-OAUTH2", "token")
+
+	token, err := v3OAuth2ClientCredentialToken(t.Context(), server.Client(), endpoint, "app-cred-id", "app-cred-secret")
+	require.NoError(t, err)
+	assert.Equal(t, "keystone-token", token)
+}
+
+func TestUnitConfigureProviderAuthOptionsTrust(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]any{
+		"trust_id": "trust-id",
+	})
+
+	config := &Config{
+		Config: auth.Config{
+			DelayedAuth: true,
+			AuthOpts: &gophercloud.AuthOptions{
+				Username:   "alice",
+				Password:   "secret",
+				TenantName: "project",
+				Scope:      &gophercloud.AuthScope{ProjectName: "project", DomainName: "Default"},
+			},
+		},
+	}
+
+	err := configureProviderAuthOptions(t.Context(), config, d)
+	require.NoError(t, err)
+	assert.Equal(t, &gophercloud.AuthScope{TrustID: "trust-id"}, config.AuthOpts.Scope)
+	assert.Empty(t, config.AuthOpts.TenantName)
+	assert.Equal(t, "alice", config.AuthOpts.Username)
+}
+
+func TestUnitConfigureProviderAuthOptionsTrustApplicationCredential(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]any{
+		"trust_id": "trust-id",
+	})
+
+	config := &Config{
+		Config: auth.Config{
+			DelayedAuth: true,
+			AuthOpts: &gophercloud.AuthOptions{
+				ApplicationCredentialID:     "app-cred-id",
+				ApplicationCredentialSecret: "app-cred-secret",
+			},
+		},
+	}
+
+	err := configureProviderAuthOptions(t.Context(), config, d)
+	require.Error(t, err)
+}
+
+func TestUnitConfigureProviderFederatedReauth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v3/auth/tokens", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Contains(t, string(body), `"id":"federated-token-2"`)
+		assert.Contains(t, string(body), `"name":"project"`)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Subject-Token", "scoped-token")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"token": {"catalog": []}}`))
+	}))
+	defer server.Close()
+
+	client, err := openstack.NewClient(server.URL + "/v3/")
+	require.NoError(t, err)
+
+	config := &Config{
+		Config: auth.Config{
+			AllowReauth: true,
+			OsClient:    client,
+			AuthOpts: &gophercloud.AuthOptions{
+				IdentityEndpoint: server.URL + "/v3/",
+				TokenID:          "federated-token-1",
+				AllowReauth:      true,
+				Scope:            &gophercloud.AuthScope{ProjectName: "project", DomainName: "Default"},
+			},
+		},
+		federatedToken: func(context.Context) (string, error) {
+			return "federated-token-2", nil
+		},
+	}
+
+	configureProviderFederatedReauth(config)
+	assert.False(t, config.AuthOpts.AllowReauth)
+
+	require.NoError(t, client.ReauthFunc(t.Context()))
+	assert.Equal(t, "scoped-token", client.Token())
+}
+
+func TestUnitConfigFederatedAuthenticate(t *testing.T) {
+	var requests atomic.Int32
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/v3/OS-FEDERATION/identity_providers/idp/protocols/openid/auth", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		assert.Equal(t, "Bearer oidc-token", r.Header.Get("Authorization"))
+		// The request is sent by the HTTP client of the provider.
+		assert.Equal(t, "no-cache", r.Header.Get("Cache-Control"))
+
+		w.Header().Set("X-Subject-Token", "federated-token")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Contains(t, string(body), `"id":"federated-token"`)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Subject-Token", "scoped-token")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"token": {"catalog": []}}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]any{
+		"auth_type":         authTypeV3OIDCAccessToken,
+		"identity_provider": "idp",
+		"protocol":          "openid",
+		"access_token":      "oidc-token",
+	})
+
+	config := &Config{
+		Config: auth.Config{
+			IdentityEndpoint: server.URL + "/v3/",
+			DelayedAuth:      true,
+		},
+	}
+
+	require.NoError(t, configureProviderAuthType(t.Context(), config, d))
+
+	client, err := openstack.NewClient(server.URL + "/v3/")
+	require.NoError(t, err)
+
+	rt := &osClient.RoundTripper{Rt: http.DefaultTransport}
+	rt.SetHeaders(http.Header{"Cache-Control": {"no-cache"}})
+	client.HTTPClient = http.Client{Transport: rt}
+
+	config.OsClient = client
+	config.AuthOpts = &gophercloud.AuthOptions{
+		IdentityEndpoint: server.URL + "/v3/",
+		Scope:            &gophercloud.AuthScope{ProjectName: "project", DomainName: "Default"},
+	}
+
+	require.NoError(t, configureProviderAuthOptions(t.Context(), config, d))
+	assert.Zero(t, requests.Load())
+
+	require.NoError(t, config.authenticate(t.Context()))
+	require.NoError(t, config.authenticate(t.Context()))
+	assert.Equal(t, int32(2), requests.Load())
+	assert.Equal(t, "scoped-token", client.Token())
+	assert.Nil(t, client.ReauthFunc)
+}
+
+func TestUnitNewProviderAuthHTTPClient(t *testing.T) {
+	config := &Config{
+		Config: auth.Config{
+			EnableLogger: true,
+		},
+		endpoints:   newServiceEndpoints(),
+		rateLimiter: newRateLimiter(newServiceEndpoints(), map[string]rateLimitSettings{"": {MaxConcurrentRequests: 1}}, 0),
+	}
+
+	client, err := newProviderAuthHTTPClient(config)
+	require.NoError(t, err)
+
+	rt, ok := client.Transport.(*rateLimitTransport)
+	require.True(t, ok)
+	assert.IsType(t, &loggingTransport{}, rt.rt)
+}
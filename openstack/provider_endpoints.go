@@ -0,0 +1,238 @@
+package openstack
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// serviceEndpoints attributes the requests of the provider HTTP client to
+// the services by the endpoints of the service clients. It is used by the
+// rate limiting and the logging of the requests, which only see the URL.
+type serviceEndpoints struct {
+	mu        sync.RWMutex
+	endpoints map[string]string
+}
+
+func newServiceEndpoints() *serviceEndpoints {
+	return &serviceEndpoints{
+		endpoints: make(map[string]string),
+	}
+}
+
+// register attributes the requests to the endpoint to the service.
+func (e *serviceEndpoints) register(endpoint, service string) {
+	if endpoint == "" {
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.endpoints[endpoint] = service
+}
+
+// service returns the service of the longest registered endpoint, which is
+// a prefix of the URL. It returns an empty string, when no endpoint
+// matches, e.g. for the authentication requests.
+func (e *serviceEndpoints) service(url string) string {
+	if e == nil {
+		return ""
+	}
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	var match, service string
+
+	for endpoint, s := range e.endpoints {
+		if strings.HasPrefix(url, endpoint) && len(endpoint) > len(match) {
+			match = endpoint
+			service = s
+		}
+	}
+
+	return service
+}
+
+// registerServiceClient attributes the requests of the service client to
+// the service.
+func (c *Config) registerServiceClient(client *gophercloud.ServiceClient, service string, err error) (*gophercloud.ServiceClient, error) {
+	if err == nil && c.endpoints != nil && client != nil {
+		c.endpoints.register(client.Endpoint, service)
+	}
+
+	return client, err
+}
+
+func (c *Config) BlockStorageV1Client(ctx context.Context, region string) (*gophercloud.ServiceClient, error) {
+	if err := c.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	client, err := c.Config.BlockStorageV1Client(ctx, region)
+
+	return c.registerServiceClient(client, "volume", err)
+}
+
+func (c *Config) BlockStorageV2Client(ctx context.Context, region string) (*gophercloud.ServiceClient, error) {
+	if err := c.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	client, err := c.Config.BlockStorageV2Client(ctx, region)
+
+	return c.registerServiceClient(client, "volumev2", err)
+}
+
+func (c *Config) BlockStorageV3Client(ctx context.Context, region string) (*gophercloud.ServiceClient, error) {
+	if err := c.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	client, err := c.Config.BlockStorageV3Client(ctx, region)
+
+	return c.registerServiceClient(client, "volumev3", err)
+}
+
+func (c *Config) ComputeV2Client(ctx context.Context, region string) (*gophercloud.ServiceClient, error) {
+	if err := c.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	client, err := c.Config.ComputeV2Client(ctx, region)
+
+	return c.registerServiceClient(client, "compute", err)
+}
+
+func (c *Config) ContainerInfraV1Client(ctx context.Context, region string) (*gophercloud.ServiceClient, error) {
+	if err := c.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	client, err := c.Config.ContainerInfraV1Client(ctx, region)
+
+	return c.registerServiceClient(client, "container-infra", err)
+}
+
+func (c *Config) DatabaseV1Client(ctx context.Context, region string) (*gophercloud.ServiceClient, error) {
+	if err := c.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	client, err := c.Config.DatabaseV1Client(ctx, region)
+
+	return c.registerServiceClient(client, "database", err)
+}
+
+func (c *Config) DNSV2Client(ctx context.Context, region string) (*gophercloud.ServiceClient, error) {
+	if err := c.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	client, err := c.Config.DNSV2Client(ctx, region)
+
+	return c.registerServiceClient(client, "dns", err)
+}
+
+func (c *Config) IdentityV3Client(ctx context.Context, region string) (*gophercloud.ServiceClient, error) {
+	if err := c.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	client, err := c.Config.IdentityV3Client(ctx, region)
+
+	return c.registerServiceClient(client, "identity", err)
+}
+
+func (c *Config) ImageV2Client(ctx context.Context, region string) (*gophercloud.ServiceClient, error) {
+	if err := c.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	client, err := c.Config.ImageV2Client(ctx, region)
+
+	return c.registerServiceClient(client, "image", err)
+}
+
+func (c *Config) KeyManagerV1Client(ctx context.Context, region string) (*gophercloud.ServiceClient, error) {
+	if err := c.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	client, err := c.Config.KeyManagerV1Client(ctx, region)
+
+	return c.registerServiceClient(client, "key-manager", err)
+}
+
+func (c *Config) LoadBalancerV2Client(ctx context.Context, region string) (*gophercloud.ServiceClient, error) {
+	if err := c.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	client, err := c.Config.LoadBalancerV2Client(ctx, region)
+
+	return c.registerServiceClient(client, "load-balancer", err)
+}
+
+func (c *Config) MessagingV2Client(ctx context.Context, clientID string, region string) (*gophercloud.ServiceClient, error) {
+	if err := c.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	client, err := c.Config.MessagingV2Client(ctx, clientID, region)
+
+	return c.registerServiceClient(client, "messaging", err)
+}
+
+func (c *Config) NetworkingV2Client(ctx context.Context, region string) (*gophercloud.ServiceClient, error) {
+	if err := c.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	client, err := c.Config.NetworkingV2Client(ctx, region)
+
+	return c.registerServiceClient(client, "network", err)
+}
+
+func (c *Config) ObjectStorageV1Client(ctx context.Context, region string) (*gophercloud.ServiceClient, error) {
+	if err := c.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	client, err := c.Config.ObjectStorageV1Client(ctx, region)
+
+	return c.registerServiceClient(client, "object-store", err)
+}
+
+func (c *Config) OrchestrationV1Client(ctx context.Context, region string) (*gophercloud.ServiceClient, error) {
+	if err := c.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	client, err := c.Config.OrchestrationV1Client(ctx, region)
+
+	return c.registerServiceClient(client, "orchestration", err)
+}
+
+func (c *Config) SharedfilesystemV2Client(ctx context.Context, region string) (*gophercloud.ServiceClient, error) {
+	if err := c.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	client, err := c.Config.SharedfilesystemV2Client(ctx, region)
+
+	return c.registerServiceClient(client, "sharev2", err)
+}
+
+func (c *Config) WorkflowV2Client(ctx context.Context, region string) (*gophercloud.ServiceClient, error) {
+	if err := c.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	client, err := c.Config.WorkflowV2Client(ctx, region)
+
+	return c.registerServiceClient(client, "workflowv2", err)
+}
@@ -0,0 +1,17 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitServiceEndpointsService(t *testing.T) {
+	endpoints := newServiceEndpoints()
+	endpoints.register("https://example.com/", "identity")
+	endpoints.register("https://example.com/network/v2.0/", "network")
+
+	assert.Equal(t, "network", endpoints.service("https://example.com/network/v2.0/ports"))
+	assert.Equal(t, "identity", endpoints.service("https://example.com/v3/projects"))
+	assert.Empty(t, endpoints.service("https://keystone.example.com/v3/auth/tokens"))
+}
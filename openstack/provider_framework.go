@@ -0,0 +1,214 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ProviderServers returns the SDK provider and the plugin framework provider,
+// which serves the ephemeral resources. Both are meant to be combined with
+// tf5muxserver.NewMuxServer. The framework provider shares the configuration
+// of the SDK provider.
+func ProviderServers() []func() tfprotov5.ProviderServer {
+	sdkProvider := Provider()
+
+	return []func() tfprotov5.ProviderServer{
+		sdkProvider.GRPCProvider,
+		providerserver.NewProtocol5(&frameworkProvider{sdkProvider: sdkProvider}),
+	}
+}
+
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+var (
+	_ provider.Provider                       = &frameworkProvider{}
+	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+)
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "openstack"
+	resp.Version = version
+}
+
+// Schema returns the schema of the SDK provider, since the provider schemas
+// of muxed servers have to be identical.
+func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	attributes, blocks, diags := frameworkProviderSchemaAttributes(p.sdkProvider.Schema)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Schema = providerschema.Schema{
+		Attributes: attributes,
+		Blocks:     blocks,
+	}
+}
+
+// Configure passes the configuration of the SDK provider to the ephemeral
+// resources. The SDK provider is configured first by the mux server.
+func (p *frameworkProvider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	if config, ok := p.sdkProvider.Meta().(*Config); ok {
+		resp.EphemeralResourceData = config
+	}
+}
+
+func (p *frameworkProvider) Resources(context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) DataSources(context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		func() ephemeral.EphemeralResource { return &containerInfraKubeconfigV1EphemeralResource{} },
+	}
+}
+
+func frameworkProviderSchemaAttributes(s map[string]*schema.Schema) (map[string]providerschema.Attribute, map[string]providerschema.Block, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := make(map[string]providerschema.Attribute)
+	blocks := make(map[string]providerschema.Block)
+
+	for name, v := range s {
+		if r, ok := v.Elem.(*schema.Resource); ok && v.Type == schema.TypeList {
+			nestedAttributes, nestedBlocks, nestedDiags := frameworkProviderSchemaAttributes(r.Schema)
+			diags.Append(nestedDiags...)
+
+			blocks[name] = providerschema.ListNestedBlock{
+				Description:        v.Description,
+				DeprecationMessage: v.Deprecated,
+				NestedObject: providerschema.NestedBlockObject{
+					Attributes: nestedAttributes,
+					Blocks:     nestedBlocks,
+				},
+			}
+
+			continue
+		}
+
+		attribute, err := frameworkProviderSchemaAttribute(v)
+		if err != nil {
+			diags.AddError("Unsupported provider schema attribute",
+				fmt.Sprintf("The provider schema attribute %s cannot be served by the plugin framework: %s", name, err))
+
+			continue
+		}
+
+		attributes[name] = attribute
+	}
+
+	return attributes, blocks, diags
+}
+
+func frameworkProviderSchemaAttribute(s *schema.Schema) (providerschema.Attribute, error) {
+	switch s.Type {
+	case schema.TypeString:
+		return providerschema.StringAttribute{
+			Description:        s.Description,
+			Required:           s.Required,
+			Optional:           s.Optional,
+			Sensitive:          s.Sensitive,
+			DeprecationMessage: s.Deprecated,
+		}, nil
+	case schema.TypeBool:
+		return providerschema.BoolAttribute{
+			Description:        s.Description,
+			Required:           s.Required,
+			Optional:           s.Optional,
+			Sensitive:          s.Sensitive,
+			DeprecationMessage: s.Deprecated,
+		}, nil
+	case schema.TypeInt:
+		return providerschema.Int64Attribute{
+			Description:        s.Description,
+			Required:           s.Required,
+			Optional:           s.Optional,
+			Sensitive:          s.Sensitive,
+			DeprecationMessage: s.Deprecated,
+		}, nil
+	case schema.TypeFloat:
+		return providerschema.Float64Attribute{
+			Description:        s.Description,
+			Required:           s.Required,
+			Optional:           s.Optional,
+			Sensitive:          s.Sensitive,
+			DeprecationMessage: s.Deprecated,
+		}, nil
+	case schema.TypeList, schema.TypeSet, schema.TypeMap:
+		elemType, err := frameworkProviderSchemaElemType(s.Elem)
+		if err != nil {
+			return nil, err
+		}
+
+		switch s.Type {
+		case schema.TypeList:
+			return providerschema.ListAttribute{
+				ElementType:        elemType,
+				Description:        s.Description,
+				Required:           s.Required,
+				Optional:           s.Optional,
+				Sensitive:          s.Sensitive,
+				DeprecationMessage: s.Deprecated,
+			}, nil
+		case schema.TypeSet:
+			return providerschema.SetAttribute{
+				ElementType:        elemType,
+				Description:        s.Description,
+				Required:           s.Required,
+				Optional:           s.Optional,
+				Sensitive:          s.Sensitive,
+				DeprecationMessage: s.Deprecated,
+			}, nil
+		default:
+			return providerschema.MapAttribute{
+				ElementType:        elemType,
+				Description:        s.Description,
+				Required:           s.Required,
+				Optional:           s.Optional,
+				Sensitive:          s.Sensitive,
+				DeprecationMessage: s.Deprecated,
+			}, nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported type %s", s.Type)
+	}
+}
+
+// frameworkProviderSchemaElemType returns the element type of a collection.
+// The SDK defaults to strings, when no element is set.
+func frameworkProviderSchemaElemType(elem any) (attr.Type, error) {
+	if elem == nil {
+		return types.StringType, nil
+	}
+
+	s, ok := elem.(*schema.Schema)
+	if !ok {
+		return nil, fmt.Errorf("unsupported element %T", elem)
+	}
+
+	switch s.Type {
+	case schema.TypeString:
+		return types.StringType, nil
+	case schema.TypeBool:
+		return types.BoolType, nil
+	case schema.TypeInt, schema.TypeFloat:
+		return types.NumberType, nil
+	default:
+		return nil, fmt.Errorf("unsupported element type %s", s.Type)
+	}
+}
@@ -0,0 +1,42 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitProviderServersSchema(t *testing.T) {
+	muxServer, err := tf5muxserver.NewMuxServer(t.Context(), ProviderServers()...)
+	require.NoError(t, err)
+
+	resp, err := muxServer.ProviderServer().GetProviderSchema(t.Context(), &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Diagnostics)
+
+	require.Contains(t, resp.EphemeralResourceSchemas, "openstack_containerinfra_kubeconfig_v1")
+	assert.Contains(t, resp.ResourceSchemas, "openstack_containerinfra_cluster_v1")
+
+	for _, attribute := range resp.EphemeralResourceSchemas["openstack_containerinfra_kubeconfig_v1"].Block.Attributes {
+		switch attribute.Name {
+		case "raw_config", "client_certificate", "client_key":
+			assert.True(t, attribute.Sensitive, attribute.Name)
+		}
+	}
+}
+
+func TestUnitFrameworkProviderSchemaAttributesUnsupported(t *testing.T) {
+	_, _, diags := frameworkProviderSchemaAttributes(map[string]*schema.Schema{
+		"invalid": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeList},
+		},
+	})
+
+	assert.True(t, diags.HasError())
+}
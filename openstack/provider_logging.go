@@ -0,0 +1,273 @@
+package openstack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	osClient "github.com/gophercloud/utils/v2/client"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const redactedLogValue = "***"
+
+// loggingSensitiveHeaders returns the lower case names of the headers, which
+// are redacted in the logs.
+func loggingSensitiveHeaders() map[string]struct{} {
+	headers := map[string]struct{}{
+		"x-auth-token":     {},
+		"x-subject-token":  {},
+		"x-service-token":  {},
+		"x-storage-token":  {},
+		"authorization":    {},
+		"set-cookie":       {},
+		"cookie":           {},
+		"x-auth-key":       {},
+		"x-auth-password":  {},
+		"openstack-secret": {},
+	}
+
+	for _, h := range osClient.GetDefaultSensitiveHeaders() {
+		headers[strings.ToLower(h)] = struct{}{}
+	}
+
+	return headers
+}
+
+// loggingSensitiveFields returns the lower case names of the JSON fields,
+// which are redacted in the logs. Vendor prefixes like
+// "OS-EXT-SRV-ATTR:" are ignored.
+func loggingSensitiveFields() map[string]struct{} {
+	return map[string]struct{}{
+		"password":                      {},
+		"original_password":             {},
+		"new_password":                  {},
+		"adminpass":                     {},
+		"admin_pass":                    {},
+		"secret":                        {},
+		"client_secret":                 {},
+		"application_credential_secret": {},
+		"payload":                       {},
+		"private_key":                   {},
+		"user_data":                     {},
+		"token":                         {},
+		"access_token":                  {},
+		"refresh_token":                 {},
+		"id_token":                      {},
+		"passphrase":                    {},
+		"psk":                           {},
+		"blob":                          {},
+		"catalog":                       {},
+	}
+}
+
+// loggingTransport is an http.RoundTripper, which logs the requests and the
+// responses with terraform-plugin-log. Tokens, passwords and other secrets
+// are redacted.
+type loggingTransport struct {
+	rt        http.RoundTripper
+	endpoints *serviceEndpoints
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	fields := map[string]any{
+		"method": req.Method,
+		"url":    redactLogURL(req.URL),
+	}
+
+	if service := t.endpoints.service(req.URL.String()); service != "" {
+		fields["service"] = service
+	}
+
+	r := req
+	requestFields := map[string]any{
+		"headers": redactLogHeaders(req.Header),
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		if isJSONContentType(req.Header.Get("Content-Type")) {
+			body, err := io.ReadAll(req.Body)
+			req.Body.Close()
+
+			if err != nil {
+				return nil, err
+			}
+
+			r = req.Clone(ctx)
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			requestFields["body"] = redactLogJSON(body)
+		} else {
+			requestFields["body"] = fmt.Sprintf("%s body omitted", req.Header.Get("Content-Type"))
+		}
+	}
+
+	tflog.Debug(ctx, "OpenStack request", mergeLogFields(fields, requestFields))
+
+	start := time.Now()
+	resp, err := t.rt.RoundTrip(r)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "OpenStack request failed", fields)
+
+		return nil, err
+	}
+
+	fields["status"] = resp.StatusCode
+
+	if v := resp.Header.Get("X-Openstack-Request-Id"); v != "" {
+		fields["request_id"] = v
+	} else if v := resp.Header.Get("X-Compute-Request-Id"); v != "" {
+		fields["request_id"] = v
+	}
+
+	responseFields := map[string]any{
+		"headers": redactLogHeaders(resp.Header),
+	}
+
+	if isJSONContentType(resp.Header.Get("Content-Type")) {
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		if len(body) > 0 {
+			responseFields["body"] = redactLogJSON(body)
+		}
+	}
+
+	tflog.Debug(ctx, "OpenStack response", mergeLogFields(fields, responseFields))
+
+	return resp, nil
+}
+
+func mergeLogFields(fields ...map[string]any) map[string]any {
+	merged := make(map[string]any)
+
+	for _, f := range fields {
+		for k, v := range f {
+			merged[k] = v
+		}
+	}
+
+	return merged
+}
+
+func isJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "application/json" ||
+		strings.HasSuffix(mediaType, "+json") ||
+		strings.HasSuffix(mediaType, "-json-patch")
+}
+
+// redactLogURL redacts the signature of Swift temporary URLs.
+func redactLogURL(u *url.URL) string {
+	q := u.Query()
+	if !q.Has("temp_url_sig") {
+		return u.String()
+	}
+
+	q.Set("temp_url_sig", redactedLogValue)
+
+	redacted := *u
+	redacted.RawQuery = q.Encode()
+
+	return redacted.String()
+}
+
+// redactLogHeaders returns the headers as a flat map with the sensitive
+// header values redacted.
+func redactLogHeaders(headers http.Header) map[string]string {
+	sensitive := loggingSensitiveHeaders()
+	redacted := make(map[string]string, len(headers))
+
+	for k, v := range headers {
+		if _, ok := sensitive[strings.ToLower(k)]; ok {
+			redacted[k] = redactedLogValue
+
+			continue
+		}
+
+		redacted[k] = strings.Join(v, " ")
+	}
+
+	return redacted
+}
+
+// redactLogJSON returns the JSON body with the sensitive fields redacted.
+func redactLogJSON(body []byte) string {
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return fmt.Sprintf("%d bytes of invalid JSON omitted", len(body))
+	}
+
+	redacted, err := json.Marshal(redactLogValue(v, loggingSensitiveFields()))
+	if err != nil {
+		return fmt.Sprintf("%d bytes of JSON omitted", len(body))
+	}
+
+	return string(redacted)
+}
+
+func redactLogValue(v any, sensitive map[string]struct{}) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k := range v {
+			name := strings.ToLower(k)
+			if i := strings.LastIndex(name, ":"); i >= 0 {
+				name = name[i+1:]
+			}
+
+			if _, ok := sensitive[name]; ok && v[k] != nil {
+				v[k] = redactedLogValue
+
+				continue
+			}
+
+			v[k] = redactLogValue(v[k], sensitive)
+		}
+	case []any:
+		for i := range v {
+			v[i] = redactLogValue(v[i], sensitive)
+		}
+	}
+
+	return v
+}
+
+// configureProviderLogging replaces the request logger of gophercloud, which
+// logs the secrets in the request and response bodies, with the redacting
+// logging transport.
+func configureProviderLogging(config *Config) {
+	if !config.EnableLogger || config.OsClient == nil {
+		return
+	}
+
+	rt, ok := config.OsClient.HTTPClient.Transport.(*osClient.RoundTripper)
+	if !ok {
+		return
+	}
+
+	rt.Logger = nil
+	rt.Rt = &loggingTransport{
+		rt:        rt.Rt,
+		endpoints: config.endpoints,
+	}
+}
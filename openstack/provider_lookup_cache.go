@@ -0,0 +1,167 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	lookupCacheFlavor   = "flavor"
+	lookupCacheImage    = "image"
+	lookupCacheNetwork  = "network"
+	lookupCacheSecGroup = "secgroup"
+)
+
+// lookupCacheKey identifies a cached lookup. The provider client and the
+// endpoint are part of the key, so that lookups in different regions or
+// with different credentials never share a result.
+type lookupCacheKey struct {
+	provider *gophercloud.ProviderClient
+	endpoint string
+	kind     string
+	query    string
+	term     string
+}
+
+type lookupCacheEntry struct {
+	id      string
+	name    string
+	value   any
+	expires time.Time
+}
+
+// lookupCache is a provider scoped cache of name to ID lookups, which are
+// shared by all resources and data sources of a run. Only successful lookups,
+// which found an object, are cached. A nil *lookupCache disables caching.
+type lookupCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[lookupCacheKey]lookupCacheEntry
+	group   singleflight.Group
+	// generation is increased on every invalidation, so that a lookup,
+	// which was started before, doesn't cache a stale result.
+	generation uint64
+}
+
+func newLookupCache(ttl time.Duration) *lookupCache {
+	if ttl <= 0 {
+		return nil
+	}
+
+	return &lookupCache{
+		ttl:     ttl,
+		entries: make(map[lookupCacheKey]lookupCacheEntry),
+	}
+}
+
+// lookup returns the cached value of the kind, which was queried by the
+// query type ("id" or "name") and the term. On a cache miss fn is called and
+// its result is cached. Concurrent misses of the same key share one call of
+// fn. fn returns the ID and the name of the found object along with the
+// value to cache.
+func (c *lookupCache) lookup(client *gophercloud.ServiceClient, kind, query, term string, fn func() (string, string, any, error)) (any, error) {
+	if c == nil {
+		_, _, v, err := fn()
+
+		return v, err
+	}
+
+	key := lookupCacheKey{
+		provider: client.ProviderClient,
+		endpoint: client.Endpoint,
+		kind:     kind,
+		query:    query,
+		term:     term,
+	}
+
+	v, generation, ok := c.get(key)
+	if ok {
+		log.Printf("[DEBUG] Using cached %s lookup for %s %s", kind, query, term)
+
+		return v, nil
+	}
+
+	flight := fmt.Sprintf("%p|%s|%s|%s|%s", key.provider, key.endpoint, kind, query, term)
+	v, err, _ := c.group.Do(flight, func() (any, error) {
+		id, name, v, err := fn()
+		if err != nil || id == "" {
+			return v, err
+		}
+
+		c.set(key, generation, lookupCacheEntry{
+			id:    id,
+			name:  name,
+			value: v,
+		})
+
+		return v, nil
+	})
+
+	return v, err
+}
+
+// get returns the cached value of the key along with the current generation
+// of the cache.
+func (c *lookupCache) get(key lookupCacheKey) (any, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, c.generation, false
+	}
+
+	if time.Now().After(entry.expires) {
+		delete(c.entries, key)
+
+		return nil, c.generation, false
+	}
+
+	return entry.value, c.generation, true
+}
+
+// set caches the entry, unless the cache was invalidated after the given
+// generation.
+func (c *lookupCache) set(key lookupCacheKey, generation uint64, entry lookupCacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+
+	entry.expires = time.Now().Add(c.ttl)
+	c.entries[key] = entry
+}
+
+// invalidate removes every cached lookup of the kind, which either found
+// the object with the given ID or was done by the given name. It has to be
+// called, when the provider creates, renames or deletes an object of the
+// kind, because a new object may make a name ambiguous and a deleted object
+// must not be returned anymore.
+func (c *lookupCache) invalidate(kind, id, name string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+
+	for key, entry := range c.entries {
+		if key.kind != kind {
+			continue
+		}
+
+		if (id != "" && entry.id == id) || (name != "" && entry.name == name) {
+			delete(c.entries, key)
+		}
+	}
+}
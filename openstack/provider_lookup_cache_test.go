@@ -0,0 +1,162 @@
+package openstack
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitLookupCache(t *testing.T) {
+	provider := &gophercloud.ProviderClient{}
+	client := &gophercloud.ServiceClient{ProviderClient: provider, Endpoint: "https://image.example.com/"}
+	otherRegion := &gophercloud.ServiceClient{ProviderClient: provider, Endpoint: "https://image.other.example.com/"}
+
+	cache := newLookupCache(time.Minute)
+
+	var calls int
+
+	lookup := func(c *gophercloud.ServiceClient, name string) (any, error) {
+		return cache.lookup(c, lookupCacheImage, "name", name, func() (string, string, any, error) {
+			calls++
+
+			return "image_1", name, "image_1", nil
+		})
+	}
+
+	v, err := lookup(client, "cirros")
+	require.NoError(t, err)
+	assert.Equal(t, "image_1", v)
+
+	_, err = lookup(client, "cirros")
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+
+	// Another endpoint doesn't share the cached lookup.
+	_, err = lookup(otherRegion, "cirros")
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	// Invalidating by ID removes the lookups of all endpoints.
+	cache.invalidate(lookupCacheImage, "image_1", "")
+
+	_, err = lookup(client, "cirros")
+	require.NoError(t, err)
+	_, err = lookup(otherRegion, "cirros")
+	require.NoError(t, err)
+	assert.Equal(t, 4, calls)
+
+	// Invalidating another kind doesn't remove the lookup.
+	cache.invalidate(lookupCacheFlavor, "image_1", "cirros")
+
+	_, err = lookup(client, "cirros")
+	require.NoError(t, err)
+	assert.Equal(t, 4, calls)
+
+	// Invalidating by name removes the lookup.
+	cache.invalidate(lookupCacheImage, "image_2", "cirros")
+
+	_, err = lookup(client, "cirros")
+	require.NoError(t, err)
+	assert.Equal(t, 5, calls)
+}
+
+func TestUnitLookupCacheMiss(t *testing.T) {
+	client := &gophercloud.ServiceClient{ProviderClient: &gophercloud.ProviderClient{}, Endpoint: "https://network.example.com/"}
+	cache := newLookupCache(time.Minute)
+
+	var calls int
+
+	// Failed lookups aren't cached.
+	for range 2 {
+		_, err := cache.lookup(client, lookupCacheNetwork, "name", "private", func() (string, string, any, error) {
+			calls++
+
+			return "", "", nil, errors.New("More than one network found for name private")
+		})
+		require.Error(t, err)
+	}
+
+	assert.Equal(t, 2, calls)
+
+	// Lookups without a found object aren't cached.
+	for range 2 {
+		v, err := cache.lookup(client, lookupCacheNetwork, "pool_name", "public", func() (string, string, any, error) {
+			calls++
+
+			return "", "", "", nil
+		})
+		require.NoError(t, err)
+		assert.Empty(t, v)
+	}
+
+	assert.Equal(t, 4, calls)
+}
+
+func TestUnitLookupCacheExpiry(t *testing.T) {
+	client := &gophercloud.ServiceClient{ProviderClient: &gophercloud.ProviderClient{}, Endpoint: "https://compute.example.com/"}
+	cache := newLookupCache(time.Minute)
+
+	var calls int
+
+	lookup := func() {
+		_, err := cache.lookup(client, lookupCacheFlavor, "id", "flavor_1", func() (string, string, any, error) {
+			calls++
+
+			return "flavor_1", "m1.small", "m1.small", nil
+		})
+		require.NoError(t, err)
+	}
+
+	lookup()
+	lookup()
+	assert.Equal(t, 1, calls)
+
+	for key, entry := range cache.entries {
+		entry.expires = time.Now().Add(-time.Second)
+		cache.entries[key] = entry
+	}
+
+	lookup()
+	assert.Equal(t, 2, calls)
+}
+
+func TestUnitLookupCacheInvalidateInFlight(t *testing.T) {
+	client := &gophercloud.ServiceClient{ProviderClient: &gophercloud.ProviderClient{}, Endpoint: "https://network.example.com/"}
+	cache := newLookupCache(time.Minute)
+
+	// A lookup, which was started before an invalidation, isn't cached.
+	_, err := cache.lookup(client, lookupCacheSecGroup, "name", "default", func() (string, string, any, error) {
+		cache.invalidate(lookupCacheSecGroup, "secgroup_1", "")
+
+		return "secgroup_1", "default", "secgroup_1", nil
+	})
+	require.NoError(t, err)
+	assert.Empty(t, cache.entries)
+}
+
+func TestUnitLookupCacheDisabled(t *testing.T) {
+	cache := newLookupCache(0)
+	require.Nil(t, cache)
+
+	client := &gophercloud.ServiceClient{ProviderClient: &gophercloud.ProviderClient{}, Endpoint: "https://image.example.com/"}
+
+	var calls int
+
+	for range 2 {
+		v, err := cache.lookup(client, lookupCacheImage, "name", "cirros", func() (string, string, any, error) {
+			calls++
+
+			return "image_1", "cirros", "image_1", nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "image_1", v)
+	}
+
+	assert.Equal(t, 2, calls)
+
+	cache.invalidate(lookupCacheImage, "image_1", "cirros")
+}
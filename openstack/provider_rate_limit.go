@@ -0,0 +1,356 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// rateLimitDefaultService is the rate limit key of the default settings,
+	// which also apply to the requests, which cannot be attributed to a
+	// service client, e.g. authentication requests.
+	rateLimitDefaultService = ""
+
+	rateLimitMinBackoff = 1 * time.Second
+	rateLimitMaxBackoff = 60 * time.Second
+)
+
+// rateLimitSettings holds the request budget of a single service.
+type rateLimitSettings struct {
+	RequestsPerSecond     float64
+	MaxConcurrentRequests int
+}
+
+// serviceRateLimiter throttles the requests of a single service. Requests
+// are spaced evenly according to the requests per second budget and the
+// number of requests in flight is capped by the concurrency slots.
+type serviceRateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+	slots    chan struct{}
+}
+
+func newServiceRateLimiter(settings rateLimitSettings) *serviceRateLimiter {
+	l := &serviceRateLimiter{}
+
+	if settings.RequestsPerSecond > 0 {
+		l.interval = time.Duration(float64(time.Second) / settings.RequestsPerSecond)
+	}
+
+	if settings.MaxConcurrentRequests > 0 {
+		l.slots = make(chan struct{}, settings.MaxConcurrentRequests)
+	}
+
+	return l
+}
+
+// acquire blocks until the request is allowed to be sent. Every successful
+// call must be followed by a call to release.
+func (l *serviceRateLimiter) acquire(ctx context.Context) error {
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if l.interval == 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	if err := rateLimitSleep(ctx, delay); err != nil {
+		l.release()
+
+		return err
+	}
+
+	return nil
+}
+
+func (l *serviceRateLimiter) release() {
+	if l.slots != nil {
+		<-l.slots
+	}
+}
+
+// rateLimiter holds the rate limiters of the services. It is shared by the
+// HTTP clients of the provider, so that the requests of the authentication
+// clients count against the same budget.
+type rateLimiter struct {
+	endpoints  *serviceEndpoints
+	settings   map[string]rateLimitSettings
+	maxRetries int
+
+	mu       sync.Mutex
+	limiters map[string]*serviceRateLimiter
+}
+
+func newRateLimiter(endpoints *serviceEndpoints, settings map[string]rateLimitSettings, maxRetries int) *rateLimiter {
+	return &rateLimiter{
+		endpoints:  endpoints,
+		settings:   settings,
+		maxRetries: maxRetries,
+		limiters:   make(map[string]*serviceRateLimiter),
+	}
+}
+
+// limiter returns the rate limiter of the service or nil, if the service is
+// not throttled. Services without their own settings get their own limiter
+// with the default settings.
+func (l *rateLimiter) limiter(service string) *serviceRateLimiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if sl, ok := l.limiters[service]; ok {
+		return sl
+	}
+
+	settings, ok := l.settings[service]
+	if !ok {
+		settings, ok = l.settings[rateLimitDefaultService]
+	}
+
+	var sl *serviceRateLimiter
+	if ok {
+		sl = newServiceRateLimiter(settings)
+	}
+
+	l.limiters[service] = sl
+
+	return sl
+}
+
+// transport wraps the transport of an HTTP client with the rate limiting.
+func (l *rateLimiter) transport(rt http.RoundTripper) *rateLimitTransport {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+
+	return &rateLimitTransport{rt: rt, rateLimiter: l}
+}
+
+// rateLimitTransport is an http.RoundTripper, which throttles the requests
+// per service and retries the requests, which were rejected with a 429
+// response code. Requests rejected with a 503 response code are only retried,
+// when their method is idempotent, since the service might have processed
+// them before failing.
+type rateLimitTransport struct {
+	rt http.RoundTripper
+	*rateLimiter
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	limiter := t.limiter(t.endpoints.service(req.URL.String()))
+
+	for attempt := 0; ; attempt++ {
+		r := req
+		if attempt > 0 {
+			r = req.Clone(ctx)
+
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+
+				r.Body = body
+			}
+		}
+
+		if limiter != nil {
+			if err := limiter.acquire(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := t.rt.RoundTrip(r)
+		if err != nil {
+			if limiter != nil {
+				limiter.release()
+			}
+
+			return nil, err
+		}
+
+		retryable := rateLimitRetryable(req.Method, resp.StatusCode)
+		rewindable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+		if !retryable || !rewindable || attempt >= t.maxRetries {
+			if limiter != nil {
+				resp.Body = &rateLimitBody{ReadCloser: resp.Body, release: limiter.release}
+			}
+
+			return resp, nil
+		}
+
+		delay := rateLimitRetryDelay(resp.Header.Get("Retry-After"), attempt)
+
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		if limiter != nil {
+			limiter.release()
+		}
+
+		log.Printf("[DEBUG] OpenStack %s %s returned %d, retrying in %s (attempt %d of %d)",
+			req.Method, req.URL, resp.StatusCode, delay, attempt+1, t.maxRetries)
+
+		if err := rateLimitSleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// rateLimitRetryable reports whether a request rejected with the status code
+// can be retried. A 429 response means the request wasn't processed, while a
+// 503 response might be returned after a non idempotent request had an effect.
+func rateLimitRetryable(method string, statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusServiceUnavailable:
+		switch method {
+		case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+			return true
+		}
+	}
+
+	return false
+}
+
+// rateLimitBody releases the concurrency slot of the request, when the
+// response body is closed.
+type rateLimitBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *rateLimitBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+
+	return err
+}
+
+// rateLimitRetryDelay returns the delay of the Retry-After header, if it is
+// set. Otherwise it returns an exponential backoff with jitter.
+func rateLimitRetryDelay(retryAfter string, attempt int) time.Duration {
+	if retryAfter != "" {
+		if v, err := strconv.ParseUint(retryAfter, 10, 32); err == nil {
+			return time.Duration(v) * time.Second
+		}
+
+		if v, err := http.ParseTime(retryAfter); err == nil {
+			return max(time.Until(v), 0)
+		}
+	}
+
+	backoff := rateLimitMaxBackoff
+	if attempt < 6 {
+		backoff = min(rateLimitMinBackoff<<attempt, rateLimitMaxBackoff)
+	}
+
+	// Use half of the backoff as the minimum delay, so that the requests,
+	// which were rejected at the same time, are not retried at the same
+	// time.
+	return backoff/2 + rand.N(backoff/2+1)
+}
+
+func rateLimitSleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// expandProviderRateLimits converts the rate_limit blocks of the provider
+// into the rate limit settings keyed by the service.
+func expandProviderRateLimits(raw []any) (map[string]rateLimitSettings, error) {
+	settings := make(map[string]rateLimitSettings, len(raw))
+
+	for _, v := range raw {
+		rateLimit, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		service := rateLimit["service"].(string)
+		if _, ok := settings[service]; ok {
+			if service == rateLimitDefaultService {
+				return nil, errors.New("only one 'rate_limit' block without a 'service' may be specified")
+			}
+
+			return nil, fmt.Errorf("more than one 'rate_limit' block specified for the %q service", service)
+		}
+
+		settings[service] = rateLimitSettings{
+			RequestsPerSecond:     rateLimit["requests_per_second"].(float64),
+			MaxConcurrentRequests: rateLimit["max_concurrent_requests"].(int),
+		}
+	}
+
+	return settings, nil
+}
+
+// expandProviderRateLimiter returns the rate limiter of the provider or nil,
+// when neither rate limits nor retries are configured.
+func expandProviderRateLimiter(config *Config, d *schema.ResourceData) (*rateLimiter, error) {
+	settings, err := expandProviderRateLimits(d.Get("rate_limit").([]any))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(settings) == 0 && config.MaxRetries <= 0 {
+		return nil, nil
+	}
+
+	return newRateLimiter(config.endpoints, settings, config.MaxRetries), nil
+}
+
+// configureProviderRateLimit wraps the HTTP client of the provider with the
+// rate limiting transport, when rate limits or retries are configured.
+func configureProviderRateLimit(config *Config) {
+	client := config.OsClient
+	if config.rateLimiter == nil || client == nil {
+		return
+	}
+
+	client.HTTPClient.Transport = config.rateLimiter.transport(client.HTTPClient.Transport)
+
+	// The rate limiting transport takes care of the 429 responses, which
+	// would otherwise be retried a second time by gophercloud. This replaces
+	// the gophercloud backoff, which only honours the Retry-After header.
+	client.RetryBackoffFunc = nil
+}
@@ -0,0 +1,227 @@
+package openstack
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitRateLimitRetryDelay(t *testing.T) {
+	assert.Equal(t, 5*time.Second, rateLimitRetryDelay("5", 0))
+	assert.Equal(t, time.Duration(0), rateLimitRetryDelay("Mon, 02 Jan 2006 15:04:05 GMT", 0))
+
+	for attempt := range 10 {
+		backoff := min(rateLimitMinBackoff<<min(attempt, 6), rateLimitMaxBackoff)
+		delay := rateLimitRetryDelay("", attempt)
+
+		assert.GreaterOrEqual(t, delay, backoff/2)
+		assert.LessOrEqual(t, delay, backoff)
+	}
+}
+
+func TestUnitExpandProviderRateLimits(t *testing.T) {
+	settings, err := expandProviderRateLimits([]any{
+		map[string]any{
+			"service":                 "",
+			"requests_per_second":     10.0,
+			"max_concurrent_requests": 0,
+		},
+		map[string]any{
+			"service":                 "network",
+			"requests_per_second":     2.5,
+			"max_concurrent_requests": 4,
+		},
+	})
+	require.NoError(t, err)
+
+	expected := map[string]rateLimitSettings{
+		"":        {RequestsPerSecond: 10},
+		"network": {RequestsPerSecond: 2.5, MaxConcurrentRequests: 4},
+	}
+	assert.Equal(t, expected, settings)
+
+	_, err = expandProviderRateLimits([]any{
+		map[string]any{"service": "compute", "requests_per_second": 1.0, "max_concurrent_requests": 0},
+		map[string]any{"service": "compute", "requests_per_second": 2.0, "max_concurrent_requests": 0},
+	})
+	assert.Error(t, err)
+}
+
+func TestUnitRateLimiterLimiter(t *testing.T) {
+	limiter := newRateLimiter(newServiceEndpoints(), map[string]rateLimitSettings{
+		"":        {RequestsPerSecond: 10},
+		"network": {MaxConcurrentRequests: 2},
+	}, 0)
+
+	network := limiter.limiter("network")
+	require.NotNil(t, network)
+	assert.Equal(t, 2, cap(network.slots))
+	assert.Equal(t, time.Duration(0), network.interval)
+
+	compute := limiter.limiter("compute")
+	require.NotNil(t, compute)
+	assert.Nil(t, compute.slots)
+	assert.Equal(t, 100*time.Millisecond, compute.interval)
+
+	// Every service without own settings gets its own limiter.
+	assert.NotSame(t, compute, limiter.limiter("image"))
+	assert.Same(t, compute, limiter.limiter("compute"))
+
+	limiter = newRateLimiter(newServiceEndpoints(), map[string]rateLimitSettings{
+		"network": {MaxConcurrentRequests: 2},
+	}, 0)
+	assert.Nil(t, limiter.limiter("compute"))
+}
+
+func TestUnitRateLimitTransportRetry(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"network": {}}`, string(body))
+
+		if requests.Add(1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	transport := newRateLimiter(newServiceEndpoints(), map[string]rateLimitSettings{
+		"": {MaxConcurrentRequests: 1},
+	}, 2).transport(http.DefaultTransport)
+	client := &http.Client{Transport: transport}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, server.URL+"/networks", strings.NewReader(`{"network": {}}`))
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, int32(3), requests.Load())
+
+	// The concurrency slot has to be released with the response body.
+	assert.Empty(t, transport.limiter("").slots)
+
+	// The last response is returned, when the retries are exhausted.
+	requests.Store(0)
+	transport.maxRetries = 1
+
+	req, err = http.NewRequestWithContext(t.Context(), http.MethodPost, server.URL+"/networks", strings.NewReader(`{"network": {}}`))
+	require.NoError(t, err)
+
+	resp, err = client.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestUnitRateLimitRetryable(t *testing.T) {
+	for _, method := range []string{http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodPost, http.MethodPatch} {
+		assert.True(t, rateLimitRetryable(method, http.StatusTooManyRequests), method)
+		assert.False(t, rateLimitRetryable(method, http.StatusInternalServerError), method)
+	}
+
+	assert.True(t, rateLimitRetryable(http.MethodGet, http.StatusServiceUnavailable))
+	assert.True(t, rateLimitRetryable(http.MethodHead, http.StatusServiceUnavailable))
+	assert.True(t, rateLimitRetryable(http.MethodPut, http.StatusServiceUnavailable))
+	assert.True(t, rateLimitRetryable(http.MethodDelete, http.StatusServiceUnavailable))
+	assert.False(t, rateLimitRetryable(http.MethodPost, http.StatusServiceUnavailable))
+	assert.False(t, rateLimitRetryable(http.MethodPatch, http.StatusServiceUnavailable))
+}
+
+func TestUnitProviderConfigureRateLimitsFirstAuthentication(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		if r.Method != http.MethodPost || r.URL.Path != "/v3/auth/tokens" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		w.Header().Set("X-Subject-Token", "token")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = io.WriteString(w, `{"token": {"catalog": []}}`)
+	}))
+	defer server.Close()
+
+	p := Provider()
+	block := schema.InternalMap(p.Schema).CoreConfigSchema()
+
+	// The raw configuration is read by the provider, so the configuration
+	// is passed through the gRPC server like Terraform does.
+	ty := block.ImpliedType()
+	rateLimitTy := ty.AttributeType("rate_limit").ElementType()
+
+	config := testProviderConfigObject(ty, map[string]cty.Value{
+		"auth_url":     cty.StringVal(server.URL + "/v3"),
+		"user_name":    cty.StringVal("alice"),
+		"password":     cty.StringVal("secret"),
+		"tenant_name":  cty.StringVal("project"),
+		"delayed_auth": cty.False,
+		"rate_limit": cty.ListVal([]cty.Value{
+			testProviderConfigObject(rateLimitTy, map[string]cty.Value{
+				"max_concurrent_requests": cty.NumberIntVal(1),
+			}),
+		}),
+	})
+
+	raw, err := msgpack.Marshal(config, ty)
+	require.NoError(t, err)
+
+	resp, err := p.GRPCProvider().ConfigureProvider(t.Context(), &tfprotov5.ConfigureProviderRequest{
+		Config: &tfprotov5.DynamicValue{MsgPack: raw},
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Diagnostics)
+
+	meta := p.Meta().(*Config)
+	assert.Equal(t, int32(1), requests.Load())
+	assert.Equal(t, "token", meta.OsClient.Token())
+
+	// The first authentication request is throttled by the default limiter.
+	assert.IsType(t, &rateLimitTransport{}, meta.OsClient.HTTPClient.Transport)
+	assert.Contains(t, meta.rateLimiter.limiters, rateLimitDefaultService)
+}
+
+// testProviderConfigObject returns an object of the type with the given
+// attributes. The other attributes are null.
+func testProviderConfigObject(ty cty.Type, attrs map[string]cty.Value) cty.Value {
+	values := make(map[string]cty.Value, len(ty.AttributeTypes()))
+
+	for name, attrTy := range ty.AttributeTypes() {
+		if v, ok := attrs[name]; ok {
+			values[name] = v
+
+			continue
+		}
+
+		values[name] = cty.NullVal(attrTy)
+	}
+
+	return cty.ObjectVal(values)
+}
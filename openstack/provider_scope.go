@@ -0,0 +1,284 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// providerScope is a named project scope of the provider. The resources and
+// data sources select it with the provider_scope argument. The token of the
+// scope is obtained by re-scoping the token of the provider, so the
+// credentials of the provider are reused.
+type providerScope struct {
+	projectID         string
+	projectName       string
+	projectDomainID   string
+	projectDomainName string
+
+	// config is the cached configuration of the scope, which is created on
+	// the first use.
+	mu     sync.Mutex
+	config *Config
+}
+
+func expandProviderScopes(raw []any) (map[string]*providerScope, error) {
+	scopes := make(map[string]*providerScope, len(raw))
+
+	for _, v := range raw {
+		m, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		name := m["name"].(string)
+		if _, ok := scopes[name]; ok {
+			return nil, fmt.Errorf("Duplicate scope %q", name)
+		}
+
+		s := &providerScope{
+			projectID:         m["project_id"].(string),
+			projectName:       m["project_name"].(string),
+			projectDomainID:   m["project_domain_id"].(string),
+			projectDomainName: m["project_domain_name"].(string),
+		}
+
+		if (s.projectID == "") == (s.projectName == "") {
+			return nil, fmt.Errorf("Exactly one of project_id or project_name must be set for scope %q", name)
+		}
+
+		scopes[name] = s
+	}
+
+	return scopes, nil
+}
+
+// scoped returns the configuration of the provider scope with the given
+// name. The provider configuration itself is returned for an empty name.
+func (c *Config) scoped(ctx context.Context, name string) (*Config, error) {
+	if name == "" {
+		return c, nil
+	}
+
+	s, ok := c.scopes[name]
+	if !ok {
+		return nil, fmt.Errorf("The provider_scope %q is not defined in the provider configuration", name)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.config != nil {
+		return s.config, nil
+	}
+
+	config, err := newScopedConfig(ctx, c, s)
+	if err != nil {
+		return nil, fmt.Errorf("Error authenticating provider_scope %q: %w", name, err)
+	}
+
+	s.config = config
+
+	return config, nil
+}
+
+// newScopedConfig returns a copy of the provider configuration, which uses a
+// token re-scoped to the project of the scope. The copy shares the HTTP
+// client, and with it the rate limiting and the logging, with the provider.
+func newScopedConfig(ctx context.Context, base *Config, s *providerScope) (*Config, error) {
+	if base.Swauth {
+		return nil, errors.New("Scopes are not supported with swauth")
+	}
+
+	if err := base.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	if base.OsClient == nil || base.AuthOpts == nil {
+		return nil, errors.New("The provider is not authenticated")
+	}
+
+	domainID, domainName := s.projectDomainID, s.projectDomainName
+	if s.projectName != "" && domainID == "" && domainName == "" {
+		domainID, domainName = base.ProjectDomainID, base.ProjectDomainName
+		if domainID == "" && domainName == "" {
+			domainID = base.DefaultDomain
+		}
+	}
+
+	ao := gophercloud.AuthOptions{
+		IdentityEndpoint: base.AuthOpts.IdentityEndpoint,
+		TokenID:          base.OsClient.Token(),
+		TenantID:         s.projectID,
+		TenantName:       s.projectName,
+		Scope: &gophercloud.AuthScope{
+			ProjectID:   s.projectID,
+			ProjectName: s.projectName,
+			DomainID:    domainID,
+			DomainName:  domainName,
+		},
+	}
+
+	client, err := openstack.NewClient(ao.IdentityEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	client.HTTPClient = base.OsClient.HTTPClient
+	client.UserAgent = base.OsClient.UserAgent
+	client.MaxBackoffRetries = base.OsClient.MaxBackoffRetries
+	client.RetryBackoffFunc = base.OsClient.RetryBackoffFunc
+	client.RetryFunc = base.OsClient.RetryFunc
+
+	log.Printf("[DEBUG] Re-scoping the OpenStack token to project %s%s", s.projectID, s.projectName)
+
+	if err := openstack.Authenticate(ctx, client, ao); err != nil {
+		return nil, err
+	}
+
+	// A re-scoped token expires together with the token of the provider,
+	// so the provider is re-authenticated before the token is re-scoped
+	// again.
+	client.ReauthFunc = func(ctx context.Context) error {
+		if err := base.OsClient.Reauthenticate(ctx, ao.TokenID); err != nil {
+			return err
+		}
+
+		ao.TokenID = base.OsClient.Token()
+
+		tac := *client
+		tac.SetThrowaway(true)
+		tac.ReauthFunc = nil
+
+		if err := tac.SetTokenAndAuthResult(nil); err != nil {
+			return err
+		}
+
+		if err := openstack.Authenticate(ctx, &tac, ao); err != nil {
+			return err
+		}
+
+		client.CopyTokenFrom(&tac)
+
+		return nil
+	}
+
+	config := *base
+	config.OsClient = client
+	config.AuthOpts = &ao
+	config.DelayedAuth = false
+	config.federatedAuth = nil
+	config.TenantID = s.projectID
+	config.TenantName = s.projectName
+	config.ProjectDomainID = domainID
+	config.ProjectDomainName = domainName
+	config.scopes = nil
+
+	return &config, nil
+}
+
+// providerScopeConfig returns the provider configuration of the scope, which
+// is selected by the provider_scope argument.
+func providerScopeConfig(ctx context.Context, meta any, name string) (any, error) {
+	if name == "" {
+		return meta, nil
+	}
+
+	config, ok := meta.(*Config)
+	if !ok {
+		return nil, errors.New("The provider is not configured")
+	}
+
+	return config.scoped(ctx, name)
+}
+
+// addProviderScopeSchema adds the provider_scope argument to the resource or
+// data source and runs its functions with the configuration of the selected
+// provider scope.
+func addProviderScopeSchema(r *schema.Resource, forceNew bool) {
+	r.Schema["provider_scope"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    forceNew,
+		Description: "The name of the provider scope, which is used instead of the project of the provider.",
+	}
+
+	r.CreateContext = providerScopeContextFunc(r.CreateContext)
+	r.ReadContext = providerScopeContextFunc(r.ReadContext)
+	r.UpdateContext = providerScopeContextFunc(r.UpdateContext)
+	r.DeleteContext = providerScopeContextFunc(r.DeleteContext)
+
+	if r.Importer != nil && r.Importer.StateContext != nil {
+		r.Importer.StateContext = providerScopeImportFunc(r.Importer.StateContext)
+	}
+
+	if f := r.CustomizeDiff; f != nil {
+		r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+			config, err := providerScopeConfig(ctx, meta, diff.Get("provider_scope").(string))
+			if err != nil {
+				return err
+			}
+
+			return f(ctx, diff, config)
+		}
+	}
+}
+
+func providerScopeContextFunc(f func(context.Context, *schema.ResourceData, any) diag.Diagnostics) func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		config, err := providerScopeConfig(ctx, meta, d.Get("provider_scope").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		return f(ctx, d, config)
+	}
+}
+
+// providerScopeImportFunc accepts the import ID in the "<scope>/<id>" format,
+// when the first part of the ID is the name of a provider scope. The scope is
+// set in the state, so that the imported resource is not replaced, and the
+// rest of the ID is imported with the configuration of the scope.
+func providerScopeImportFunc(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		name, id, ok := strings.Cut(d.Id(), "/")
+		if !ok {
+			return f(ctx, d, meta)
+		}
+
+		if config, ok := meta.(*Config); !ok || config.scopes[name] == nil {
+			return f(ctx, d, meta)
+		}
+
+		config, err := providerScopeConfig(ctx, meta, name)
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(id)
+		d.Set("provider_scope", name)
+
+		results, err := f(ctx, d, config)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range results {
+			r.Set("provider_scope", name)
+		}
+
+		return results, nil
+	}
+}
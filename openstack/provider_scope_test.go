@@ -0,0 +1,231 @@
+package openstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/terraform-provider-openstack/utils/v2/auth"
+)
+
+func TestUnitExpandProviderScopes(t *testing.T) {
+	scopes, err := expandProviderScopes([]any{
+		map[string]any{
+			"name":                "landing",
+			"project_id":          "project_1",
+			"project_name":        "",
+			"project_domain_id":   "",
+			"project_domain_name": "",
+		},
+		map[string]any{
+			"name":                "shared",
+			"project_id":          "",
+			"project_name":        "shared",
+			"project_domain_id":   "",
+			"project_domain_name": "Default",
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, scopes, 2)
+	assert.Equal(t, "project_1", scopes["landing"].projectID)
+	assert.Equal(t, "shared", scopes["shared"].projectName)
+	assert.Equal(t, "Default", scopes["shared"].projectDomainName)
+
+	_, err = expandProviderScopes([]any{
+		map[string]any{"name": "landing", "project_id": "project_1", "project_name": "", "project_domain_id": "", "project_domain_name": ""},
+		map[string]any{"name": "landing", "project_id": "project_2", "project_name": "", "project_domain_id": "", "project_domain_name": ""},
+	})
+	require.Error(t, err)
+
+	_, err = expandProviderScopes([]any{
+		map[string]any{"name": "landing", "project_id": "project_1", "project_name": "landing", "project_domain_id": "", "project_domain_name": ""},
+	})
+	require.Error(t, err)
+}
+
+func testProviderScopeKeystone(t *testing.T, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v3/auth/tokens" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		var body struct {
+			Auth struct {
+				Identity struct {
+					Methods []string `json:"methods"`
+					Token   struct {
+						ID string `json:"id"`
+					} `json:"token"`
+				} `json:"identity"`
+				Scope struct {
+					Project struct {
+						ID string `json:"id"`
+					} `json:"project"`
+				} `json:"scope"`
+			} `json:"auth"`
+		}
+
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []string{"token"}, body.Auth.Identity.Methods)
+		assert.Equal(t, "project_1", body.Auth.Scope.Project.ID)
+
+		n := requests.Add(1)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Subject-Token", fmt.Sprintf("%s-scoped-%d", body.Auth.Identity.Token.ID, n))
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"token": {"expires_at": "2030-01-01T00:00:00.000000Z", "project": {"id": "project_1"}, "catalog": []}}`)
+	}))
+}
+
+func TestUnitConfigScoped(t *testing.T) {
+	var requests atomic.Int32
+
+	server := testProviderScopeKeystone(t, &requests)
+	defer server.Close()
+
+	client, err := openstack.NewClient(server.URL + "/v3/")
+	require.NoError(t, err)
+	client.SetToken("provider")
+	client.ReauthFunc = func(context.Context) error {
+		client.SetToken("provider-reauth")
+
+		return nil
+	}
+
+	config := &Config{
+		Config: auth.Config{
+			OsClient: client,
+			AuthOpts: &gophercloud.AuthOptions{IdentityEndpoint: server.URL + "/v3/"},
+			TenantID: "provider_project",
+		},
+		scopes: map[string]*providerScope{
+			"landing": {projectID: "project_1"},
+		},
+	}
+
+	same, err := config.scoped(t.Context(), "")
+	require.NoError(t, err)
+	assert.Same(t, config, same)
+
+	scoped, err := config.scoped(t.Context(), "landing")
+	require.NoError(t, err)
+	assert.Equal(t, "provider-scoped-1", scoped.OsClient.Token())
+	assert.Equal(t, "project_1", scoped.TenantID)
+	assert.Nil(t, scoped.scopes)
+
+	// The provider configuration is not changed.
+	assert.Equal(t, "provider", config.OsClient.Token())
+	assert.Equal(t, "provider_project", config.TenantID)
+
+	// The scoped configuration is cached.
+	cached, err := config.scoped(t.Context(), "landing")
+	require.NoError(t, err)
+	assert.Same(t, scoped, cached)
+	assert.Equal(t, int32(1), requests.Load())
+
+	// The provider is re-authenticated before the token is re-scoped.
+	require.NoError(t, scoped.OsClient.Reauthenticate(t.Context(), ""))
+	assert.Equal(t, "provider-reauth", config.OsClient.Token())
+	assert.Equal(t, "provider-reauth-scoped-2", scoped.OsClient.Token())
+
+	_, err = config.scoped(t.Context(), "unknown")
+	require.Error(t, err)
+}
+
+func TestUnitAddProviderScopeSchema(t *testing.T) {
+	config := &Config{}
+
+	var got any
+
+	r := &schema.Resource{
+		ReadContext: func(_ context.Context, _ *schema.ResourceData, meta any) diag.Diagnostics {
+			got = meta
+
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+
+	addProviderScopeSchema(r, true)
+	require.Contains(t, r.Schema, "provider_scope")
+	assert.True(t, r.Schema["provider_scope"].ForceNew)
+	assert.Nil(t, r.CreateContext)
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{"name": "test"})
+	assert.False(t, r.ReadContext(t.Context(), d, config).HasError())
+	assert.Same(t, config, got)
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]any{"name": "test", "provider_scope": "unknown"})
+	assert.True(t, r.ReadContext(t.Context(), d, config).HasError())
+}
+
+func TestUnitProviderScopeImport(t *testing.T) {
+	scoped := &Config{}
+	config := &Config{
+		scopes: map[string]*providerScope{
+			"team_a": {projectID: "project_1", config: scoped},
+		},
+	}
+
+	var got any
+
+	r := &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				got = meta
+
+				return schema.ImportStatePassthroughContext(ctx, d, meta)
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+
+	addProviderScopeSchema(r, true)
+
+	d := r.Data(nil)
+	d.SetId("team_a/resource_1")
+
+	results, err := r.Importer.StateContext(t.Context(), d, config)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "resource_1", results[0].Id())
+	assert.Equal(t, "team_a", results[0].Get("provider_scope"))
+	assert.Same(t, scoped, got)
+
+	// Composite IDs, which don't start with a scope, are imported as is.
+	d = r.Data(nil)
+	d.SetId("zone_1/recordset_1")
+
+	results, err = r.Importer.StateContext(t.Context(), d, config)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "zone_1/recordset_1", results[0].Id())
+	assert.Empty(t, results[0].Get("provider_scope"))
+	assert.Same(t, config, got)
+}
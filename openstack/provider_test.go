@@ -44,7 +44,12 @@ var (
 	osHypervisorEnvironment      = os.Getenv("OS_HYPERVISOR_HOSTNAME")
 	osPortForwardingEnvironment  = os.Getenv("OS_PORT_FORWARDING_ENVIRONMENT")
 	osTaaSEnvironment            = os.Getenv("OS_TAAS_ENVIRONMENT")
+	osLocalIPEnvironment         = os.Getenv("OS_LOCAL_IP_ENVIRONMENT")
+	osNDPProxyEnvironment        = os.Getenv("OS_NDP_PROXY_ENVIRONMENT")
+	osConntrackHelperEnvironment = os.Getenv("OS_CONNTRACK_HELPER_ENVIRONMENT")
+	osMultihomingEnvironment     = os.Getenv("OS_MULTIHOMING_ENVIRONMENT")
 	osWorkflowEnvironment        = os.Getenv("OS_WORKFLOW_ENVIRONMENT")
+	osDomainConfigEnvironment    = os.Getenv("OS_DOMAIN_CONFIG_ENVIRONMENT")
 	osMagnumHTTPProxy            = os.Getenv("OS_MAGNUM_HTTP_PROXY")
 	osMagnumHTTPSProxy           = os.Getenv("OS_MAGNUM_HTTPS_PROXY")
 	osMagnumNoProxy              = os.Getenv("OS_MAGNUM_NO_PROXY")
@@ -232,6 +237,38 @@ func testAccPreCheckTaas(t *testing.T) {
 	}
 }
 
+func testAccPreCheckLocalIP(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if osLocalIPEnvironment == "" {
+		t.Skip("This environment does not support 'local-ip' extension tests")
+	}
+}
+
+func testAccPreCheckNDPProxy(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if osNDPProxyEnvironment == "" {
+		t.Skip("This environment does not support 'l3-ndp-proxy' extension tests")
+	}
+}
+
+func testAccPreCheckConntrackHelper(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if osConntrackHelperEnvironment == "" {
+		t.Skip("This environment does not support 'l3-conntrack-helper' extension tests")
+	}
+}
+
+func testAccPreCheckMultihoming(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if osMultihomingEnvironment == "" {
+		t.Skip("This environment does not support 'external-gateway-multihoming' extension tests")
+	}
+}
+
 func testAccPreCheckWorkflow(t *testing.T) {
 	testAccPreCheckRequiredEnvVars(t)
 
@@ -240,6 +277,14 @@ func testAccPreCheckWorkflow(t *testing.T) {
 	}
 }
 
+func testAccPreCheckDomainConfig(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if osDomainConfigEnvironment == "" {
+		t.Skip("This environment does not support domain-specific identity driver tests")
+	}
+}
+
 func testAccPreCheckAdminOnly(t *testing.T) {
 	v := os.Getenv("OS_USERNAME")
 	if v != "admin" {
@@ -445,7 +490,7 @@ func testAccAuthFromEnv(ctx context.Context) (*Config, error) {
 	}
 
 	config := Config{
-		auth.Config{
+		Config: auth.Config{
 			CACertFile:                  os.Getenv("OS_CACERT"),
 			ClientCertFile:              os.Getenv("OS_CERT"),
 			ClientKeyFile:               os.Getenv("OS_KEY"),
@@ -232,7 +232,7 @@ func resourceBlockStorageVolumeV3Create(ctx context.Context, d *schema.ResourceD
 
 	v, err := volumes.Create(ctx, blockStorageClient, createOpts, schedulerHints).Extract()
 	if err != nil {
-		return diag.Errorf("Error creating openstack_blockstorage_volume_v3: %s", err)
+		return diagOpenStackQuotaErrorf(ctx, err, blockStorageV3QuotaUsage(blockStorageClient), "Error creating openstack_blockstorage_volume_v3")
 	}
 
 	d.SetId(v.ID)
@@ -394,7 +394,7 @@ func resourceBlockStorageVolumeV3Update(ctx context.Context, d *schema.ResourceD
 
 	_, err = volumes.Update(ctx, blockStorageClient, d.Id(), updateOpts).Extract()
 	if err != nil {
-		return diag.Errorf("Error updating openstack_blockstorage_volume_v3 %s: %s", d.Id(), err)
+		return diagOpenStackErrorf(err, "Error updating openstack_blockstorage_volume_v3 %s", d.Id())
 	}
 
 	return resourceBlockStorageVolumeV3Read(ctx, d, meta)
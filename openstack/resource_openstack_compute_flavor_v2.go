@@ -133,9 +133,11 @@ func resourceComputeFlavorV2Create(ctx context.Context, d *schema.ResourceData,
 
 	fl, err := flavors.Create(ctx, computeClient, &createOpts).Extract()
 	if err != nil {
-		return diag.Errorf("Error creating openstack_compute_flavor_v2 %s: %s", name, err)
+		return diagOpenStackErrorf(err, "Error creating openstack_compute_flavor_v2 %s", name)
 	}
 
+	config.lookupCache.invalidate(lookupCacheFlavor, fl.ID, fl.Name)
+
 	d.SetId(fl.ID)
 
 	extraSpecsRaw := d.Get("extra_specs").(map[string]any)
@@ -144,7 +146,7 @@ func resourceComputeFlavorV2Create(ctx context.Context, d *schema.ResourceData,
 
 		_, err := flavors.CreateExtraSpecs(ctx, computeClient, fl.ID, extraSpecs).Extract()
 		if err != nil {
-			return diag.Errorf("Error creating extra_specs for openstack_compute_flavor_v2 %s: %s", fl.ID, err)
+			return diagOpenStackErrorf(err, "Error creating extra_specs for openstack_compute_flavor_v2 %s", fl.ID)
 		}
 	}
 
@@ -245,7 +247,7 @@ func resourceComputeFlavorV2Update(ctx context.Context, d *schema.ResourceData,
 
 			_, err := flavors.CreateExtraSpecs(ctx, computeClient, d.Id(), extraSpecs).Extract()
 			if err != nil {
-				return diag.Errorf("Error creating extra_specs for openstack_compute_flavor_v2 %s: %s", d.Id(), err)
+				return diagOpenStackErrorf(err, "Error creating extra_specs for openstack_compute_flavor_v2 %s", d.Id())
 			}
 		}
 	}
@@ -262,6 +264,8 @@ func resourceComputeFlavorV2Delete(ctx context.Context, d *schema.ResourceData,
 	}
 
 	err = flavors.Delete(ctx, computeClient, d.Id()).ExtractErr()
+	config.lookupCache.invalidate(lookupCacheFlavor, d.Id(), "")
+
 	if err != nil {
 		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_compute_flavor_v2"))
 	}
@@ -15,14 +15,10 @@ import (
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
-	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/flavors"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/keypairs"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/secgroups"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/tags"
-	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
-	flavorsutils "github.com/gophercloud/utils/v2/openstack/compute/v2/flavors"
-	imagesutils "github.com/gophercloud/utils/v2/openstack/image/v2/images"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -462,7 +458,7 @@ func resourceComputeInstanceV2Create(ctx context.Context, d *schema.ResourceData
 	// If a bootable block_device was specified, ignore the image altogether.
 	// If an image_id was specified, use it.
 	// If an image_name was specified, look up the image ID, report if error.
-	imageID, err := getImageIDFromConfig(ctx, imageClient, d)
+	imageID, err := getImageIDFromConfig(ctx, config.lookupCache, imageClient, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -470,7 +466,7 @@ func resourceComputeInstanceV2Create(ctx context.Context, d *schema.ResourceData
 	// Determines the Flavor ID using the following rules:
 	// If a flavor_id was specified, use it.
 	// If a flavor_name was specified, lookup the flavor ID, report if error.
-	flavorID, err := getFlavorID(ctx, computeClient, d)
+	flavorID, err := getFlavorID(ctx, config.lookupCache, computeClient, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -578,47 +574,104 @@ func resourceComputeInstanceV2Create(ctx context.Context, d *schema.ResourceData
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 
-	// If a block_device is used, use the bootfromvolume.Create function as it allows an empty ImageRef.
-	// Otherwise, use the normal servers.Create function.
-	server, err := servers.Create(ctx, computeClient, createOptsBuilder, schedulerHints).Extract()
-	if err != nil {
-		return diag.Errorf("Error creating OpenStack server: %s", err)
-	}
+	// The attempts to schedule the instance share the create timeout.
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
 
-	log.Printf("[INFO] Instance ID: %s", server.ID)
+	for attempt := 0; ; attempt++ {
+		// If a block_device is used, use the bootfromvolume.Create function as it allows an empty ImageRef.
+		// Otherwise, use the normal servers.Create function.
+		server, err := servers.Create(ctx, computeClient, createOptsBuilder, schedulerHints).Extract()
+		if err != nil {
+			return diagOpenStackQuotaErrorf(ctx, err, computeV2QuotaUsage(computeClient), "Error creating OpenStack server")
+		}
 
-	// Store the ID now
-	d.SetId(server.ID)
+		log.Printf("[INFO] Instance ID: %s", server.ID)
 
-	// Wait for the instance to become running so we can get some attributes
-	// that aren't available until later.
-	log.Printf(
-		"[DEBUG] Waiting for instance (%s) to become running",
-		server.ID)
+		// Store the ID now
+		d.SetId(server.ID)
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"BUILD"},
-		Target:     []string{"ACTIVE"},
-		Refresh:    ServerV2StateRefreshFunc(ctx, computeClient, server.ID),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      0,
-		MinTimeout: 3 * time.Second,
-	}
+		// Wait for the instance to become running so we can get some attributes
+		// that aren't available until later.
+		log.Printf(
+			"[DEBUG] Waiting for instance (%s) to become running",
+			server.ID)
 
-	err = retry.RetryContext(ctx, stateConf.Timeout, func() *retry.RetryError {
-		_, err = stateConf.WaitForStateContext(ctx)
+		stateConf := &retry.StateChangeConf{
+			Pending:    []string{"BUILD"},
+			Target:     []string{"ACTIVE"},
+			Refresh:    ServerV2StateRefreshFunc(ctx, computeClient, server.ID),
+			Timeout:    time.Until(deadline),
+			Delay:      0,
+			MinTimeout: 3 * time.Second,
+		}
+
+		err = retry.RetryContext(ctx, stateConf.Timeout, func() *retry.RetryError {
+			_, err = stateConf.WaitForStateContext(ctx)
+			if err != nil {
+				log.Printf("[DEBUG] Retrying after error: %s", err)
+
+				return checkForRetryableError(err)
+			}
+
+			return nil
+		})
+		if err == nil {
+			break
+		}
+
+		fault, noValidHost := computeV2InstanceNoValidHost(ctx, computeClient, server.ID)
+		if !noValidHost {
+			return diag.Errorf(
+				"Error waiting for instance (%s) to become ready: %s",
+				server.ID, err)
+		}
+
+		if attempt >= config.retryOnNoValidHost {
+			return diag.Errorf(
+				"Error waiting for instance (%s) to become ready: %s: %s",
+				server.ID, err, fault)
+		}
+
+		// The scheduling may succeed, once other instances are deleted or
+		// the hosts are not busy any more.
+		log.Printf("[DEBUG] Instance (%s) failed to be scheduled: %s, recreating it (attempt %d of %d)",
+			server.ID, fault, attempt+1, config.retryOnNoValidHost)
+
+		err = servers.Delete(ctx, computeClient, server.ID).ExtractErr()
+		if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return diag.Errorf("Error deleting instance (%s), which failed to be scheduled: %s", server.ID, err)
+		}
+
+		deleteStateConf := &retry.StateChangeConf{
+			Pending:    []string{"ACTIVE", "ERROR"},
+			Target:     []string{"DELETED", "SOFT_DELETED"},
+			Refresh:    ServerV2StateRefreshFunc(ctx, computeClient, server.ID),
+			Timeout:    time.Until(deadline),
+			Delay:      0,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = deleteStateConf.WaitForStateContext(ctx)
 		if err != nil {
-			log.Printf("[DEBUG] Retrying after error: %s", err)
+			return diag.Errorf("Error waiting for instance (%s) to Delete: %s", server.ID, err)
+		}
 
-			return checkForRetryableError(err)
+		d.SetId("")
+
+		backoff := computeV2InstanceNoValidHostBackoff(attempt)
+		if time.Until(deadline) <= backoff {
+			return diag.Errorf(
+				"Error waiting for instance (%s) to become ready: timeout while retrying to schedule the instance: %s",
+				server.ID, fault)
 		}
 
-		return nil
-	})
-	if err != nil {
-		return diag.Errorf(
-			"Error waiting for instance (%s) to become ready: %s",
-			server.ID, err)
+		log.Printf("[DEBUG] Waiting %s before recreating instance (%s)", backoff, server.ID)
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return diag.FromErr(ctx.Err())
+		}
 	}
 
 	vmState := d.Get("power_state").(string)
@@ -730,7 +783,7 @@ func resourceComputeInstanceV2Read(ctx context.Context, d *schema.ResourceData,
 
 	d.Set("flavor_id", flavorID)
 
-	flavor, err := flavors.Get(ctx, computeClient, flavorID).Extract()
+	flavorName, err := computeFlavorV2Name(ctx, config.lookupCache, computeClient, flavorID)
 	if err != nil {
 		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
 			// Original flavor was deleted, but it is possible that instance started
@@ -742,11 +795,11 @@ func resourceComputeInstanceV2Read(ctx context.Context, d *schema.ResourceData,
 			return diag.FromErr(err)
 		}
 	} else {
-		d.Set("flavor_name", flavor.Name)
+		d.Set("flavor_name", flavorName)
 	}
 
 	// Set the instance's image information appropriately
-	if err := setImageInformation(ctx, imageClient, server, d); err != nil {
+	if err := setImageInformation(ctx, config.lookupCache, imageClient, server, d); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -797,7 +850,7 @@ func resourceComputeInstanceV2Update(ctx context.Context, d *schema.ResourceData
 	if updateOpts != (servers.UpdateOpts{}) {
 		_, err := servers.Update(ctx, computeClient, d.Id(), updateOpts).Extract()
 		if err != nil {
-			return diag.Errorf("Error updating OpenStack server: %s", err)
+			return diagOpenStackErrorf(err, "Error updating OpenStack server")
 		}
 	}
 
@@ -951,7 +1004,7 @@ func resourceComputeInstanceV2Update(ctx context.Context, d *schema.ResourceData
 
 		_, err := servers.UpdateMetadata(ctx, computeClient, d.Id(), metadataOpts).Extract()
 		if err != nil {
-			return diag.Errorf("Error updating OpenStack server (%s) metadata: %s", d.Id(), err)
+			return diagOpenStackErrorf(err, "Error updating OpenStack server (%s) metadata", d.Id())
 		}
 	}
 
@@ -1018,7 +1071,7 @@ func resourceComputeInstanceV2Update(ctx context.Context, d *schema.ResourceData
 		} else {
 			newFlavorName := d.Get("flavor_name").(string)
 
-			newFlavorID, err = flavorsutils.IDFromName(ctx, computeClient, newFlavorName)
+			newFlavorID, err = computeFlavorV2IDFromName(ctx, config.lookupCache, computeClient, newFlavorName)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -1104,12 +1157,12 @@ func resourceComputeInstanceV2Update(ctx context.Context, d *schema.ResourceData
 		} else if d.HasChange("image_name") {
 			newImageName := d.Get("image_name").(string)
 
-			newImageID, err = imagesutils.IDFromName(ctx, computeClient, newImageName)
+			newImageID, err = imagesImageV2IDFromName(ctx, config.lookupCache, computeClient, newImageName)
 			if err != nil {
 				return diag.FromErr(err)
 			}
 		} else {
-			newImageID, err = getImageIDFromConfig(ctx, imageClient, d)
+			newImageID, err = getImageIDFromConfig(ctx, config.lookupCache, imageClient, d)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -1511,7 +1564,7 @@ func resourceInstanceSchedulerHintsV2(schedulerHintsRaw map[string]any) servers.
 	return schedulerHints
 }
 
-func getImageIDFromConfig(ctx context.Context, imageClient *gophercloud.ServiceClient, d *schema.ResourceData) (string, error) {
+func getImageIDFromConfig(ctx context.Context, cache *lookupCache, imageClient *gophercloud.ServiceClient, d *schema.ResourceData) (string, error) {
 	// If block_device was used, an Image does not need to be specified, unless an image/local
 	// combination was used. This emulates normal boot behavior. Otherwise, ignore the image altogether.
 	if vL, ok := d.GetOk("block_device"); ok {
@@ -1546,7 +1599,7 @@ func getImageIDFromConfig(ctx context.Context, imageClient *gophercloud.ServiceC
 	}
 
 	if imageName != "" {
-		imageID, err := imagesutils.IDFromName(ctx, imageClient, imageName)
+		imageID, err := imagesImageV2IDFromName(ctx, cache, imageClient, imageName)
 		if err != nil {
 			return "", err
 		}
@@ -1557,7 +1610,7 @@ func getImageIDFromConfig(ctx context.Context, imageClient *gophercloud.ServiceC
 	return "", errors.New("Neither a boot device, image ID, or image name were able to be determined")
 }
 
-func setImageInformation(ctx context.Context, imageClient *gophercloud.ServiceClient, server *servers.Server, d *schema.ResourceData) error {
+func setImageInformation(ctx context.Context, cache *lookupCache, imageClient *gophercloud.ServiceClient, server *servers.Server, d *schema.ResourceData) error {
 	// If block_device was used, an Image does not need to be specified, unless an image/local
 	// combination was used. This emulates normal boot behavior. Otherwise, ignore the image altogether.
 	if vL, ok := d.GetOk("block_device"); ok {
@@ -1582,7 +1635,7 @@ func setImageInformation(ctx context.Context, imageClient *gophercloud.ServiceCl
 		if imageID != "" {
 			d.Set("image_id", imageID)
 
-			image, err := images.Get(ctx, imageClient, imageID).Extract()
+			imageName, err := imagesImageV2Name(ctx, cache, imageClient, imageID)
 			if err != nil {
 				if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
 					// If the image name can't be found, set the value to "Image not found".
@@ -1596,14 +1649,14 @@ func setImageInformation(ctx context.Context, imageClient *gophercloud.ServiceCl
 				return err
 			}
 
-			d.Set("image_name", image.Name)
+			d.Set("image_name", imageName)
 		}
 	}
 
 	return nil
 }
 
-func getFlavorID(ctx context.Context, computeClient *gophercloud.ServiceClient, d *schema.ResourceData) (string, error) {
+func getFlavorID(ctx context.Context, cache *lookupCache, computeClient *gophercloud.ServiceClient, d *schema.ResourceData) (string, error) {
 	if flavorID := d.Get("flavor_id").(string); flavorID != "" {
 		return flavorID, nil
 	}
@@ -1621,7 +1674,7 @@ func getFlavorID(ctx context.Context, computeClient *gophercloud.ServiceClient,
 	}
 
 	if flavorName != "" {
-		flavorID, err := flavorsutils.IDFromName(ctx, computeClient, flavorName)
+		flavorID, err := computeFlavorV2IDFromName(ctx, cache, computeClient, flavorName)
 		if err != nil {
 			return "", err
 		}
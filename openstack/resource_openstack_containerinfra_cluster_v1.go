@@ -8,11 +8,13 @@ import (
 	"time"
 
 	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/containerinfra/v1/certificates"
 	"github.com/gophercloud/gophercloud/v2/openstack/containerinfra/v1/clusters"
 	"github.com/gophercloud/gophercloud/v2/openstack/containerinfra/v1/nodegroups"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceContainerInfraClusterV1() *schema.Resource {
@@ -212,6 +214,27 @@ func resourceContainerInfraClusterV1() *schema.Resource {
 				Computed: true,
 			},
 
+			"max_batch_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"nodegroup": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ca_rotation_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"disable_kubeconfig": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"kubeconfig": {
 				Type:      schema.TypeMap,
 				Computed:  true,
@@ -427,12 +450,33 @@ func resourceContainerInfraClusterV1Update(ctx context.Context, d *schema.Resour
 		return diag.Errorf("Error creating OpenStack container infra client: %s", err)
 	}
 
-	if d.HasChange("cluster_template_id") {
-		clusterTemplateID := d.Get("cluster_template_id").(string)
+	// The nodegroup only selects the node group of the upgrade, that is
+	// requested by changing it. Magnum keeps the cluster template of the
+	// cluster for a node group upgrade, so a later change of the
+	// cluster_template_id upgrades the whole cluster.
+	nodeGroup := ""
+	if d.HasChange("nodegroup") {
+		nodeGroup = d.Get("nodegroup").(string)
+	}
+
+	if d.HasChange("cluster_template_id") || nodeGroup != "" {
 		upgradeOpts := clusters.UpgradeOpts{
-			ClusterTemplate: clusterTemplateID,
+			ClusterTemplate: d.Get("cluster_template_id").(string),
+			NodeGroup:       nodeGroup,
+		}
+
+		if v, ok := d.GetOk("max_batch_size"); ok {
+			maxBatchSize := v.(int)
+			upgradeOpts.MaxBatchSize = &maxBatchSize
+		}
+
+		updatedAt, err := containerInfraClusterV1UpdatedAt(ctx, containerInfraClient, d.Id())
+		if err != nil {
+			return diag.Errorf("Error retrieving openstack_containerinfra_cluster_v1 %s: %s", d.Id(), err)
 		}
 
+		log.Printf("[DEBUG] Upgrading openstack_containerinfra_cluster_v1 %s with options: %#v", d.Id(), upgradeOpts)
+
 		containerInfraClient.Microversion = containerInfraV1ClusterUpgradeMinMicroversion
 
 		_, err = clusters.Upgrade(ctx, containerInfraClient, d.Id(), upgradeOpts).Extract()
@@ -440,19 +484,35 @@ func resourceContainerInfraClusterV1Update(ctx context.Context, d *schema.Resour
 			return diag.Errorf("Error upgrading openstack_containerinfra_cluster_v1 %s: %s", d.Id(), err)
 		}
 
-		stateConf := &retry.StateChangeConf{
-			Target:       []string{"UPDATE_COMPLETE"},
-			Refresh:      containerInfraClusterV1StateRefreshFunc(ctx, containerInfraClient, d.Id()),
-			Timeout:      d.Timeout(schema.TimeoutUpdate),
-			Delay:        0,
-			PollInterval: 20 * time.Second,
+		err = containerInfraClusterV1WaitForUpdate(ctx, containerInfraClient, d.Id(), updatedAt, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf(
+				"Error waiting for openstack_containerinfra_cluster_v1 %s to upgrade: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("ca_rotation_trigger") {
+		updatedAt, err := containerInfraClusterV1UpdatedAt(ctx, containerInfraClient, d.Id())
+		if err != nil {
+			return diag.Errorf("Error retrieving openstack_containerinfra_cluster_v1 %s: %s", d.Id(), err)
+		}
+
+		log.Printf("[DEBUG] Rotating the CA certificate of openstack_containerinfra_cluster_v1 %s", d.Id())
+
+		err = certificates.Update(ctx, containerInfraClient, d.Id()).ExtractErr()
+		if err != nil {
+			return diag.Errorf("Error rotating the CA certificate of openstack_containerinfra_cluster_v1 %s: %s", d.Id(), err)
 		}
 
-		_, err = stateConf.WaitForStateContext(ctx)
+		err = containerInfraClusterV1WaitForUpdate(ctx, containerInfraClient, d.Id(), updatedAt, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.Errorf(
-				"Error waiting for openstack_containerinfra_cluster_v1 %s to upgrade: %s", d.Id(), err)
+				"Error waiting for openstack_containerinfra_cluster_v1 %s CA certificate rotation: %s", d.Id(), err)
 		}
+
+		// The client certificate was signed by the previous CA, so the
+		// kubeconfig has to be regenerated.
+		d.Set("kubeconfig", nil)
 	}
 
 	updateOpts := []clusters.UpdateOptsBuilder{}
@@ -475,20 +535,17 @@ func resourceContainerInfraClusterV1Update(ctx context.Context, d *schema.Resour
 		log.Printf(
 			"[DEBUG] Updating openstack_containerinfra_cluster_v1 %s with options: %#v", d.Id(), updateOpts)
 
-		_, err = clusters.Update(ctx, containerInfraClient, d.Id(), updateOpts).Extract()
+		updatedAt, err := containerInfraClusterV1UpdatedAt(ctx, containerInfraClient, d.Id())
 		if err != nil {
-			return diag.Errorf("Error updating openstack_containerinfra_cluster_v1 %s: %s", d.Id(), err)
+			return diag.Errorf("Error retrieving openstack_containerinfra_cluster_v1 %s: %s", d.Id(), err)
 		}
 
-		stateConf := &retry.StateChangeConf{
-			Target:       []string{"UPDATE_COMPLETE"},
-			Refresh:      containerInfraClusterV1StateRefreshFunc(ctx, containerInfraClient, d.Id()),
-			Timeout:      d.Timeout(schema.TimeoutUpdate),
-			Delay:        0,
-			PollInterval: 20 * time.Second,
+		_, err = clusters.Update(ctx, containerInfraClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_containerinfra_cluster_v1 %s: %s", d.Id(), err)
 		}
 
-		_, err = stateConf.WaitForStateContext(ctx)
+		err = containerInfraClusterV1WaitForUpdate(ctx, containerInfraClient, d.Id(), updatedAt, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.Errorf(
 				"Error waiting for openstack_containerinfra_cluster_v1 %s to become updated: %s", d.Id(), err)
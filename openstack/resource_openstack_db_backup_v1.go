@@ -0,0 +1,218 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDatabaseBackupV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDatabaseBackupV1Create,
+		ReadContext:   resourceDatabaseBackupV1Read,
+		DeleteContext: resourceDatabaseBackupV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"parent_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"swift_container": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"size": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"location_ref": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"datastore": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDatabaseBackupV1Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	databaseV1Client, err := config.DatabaseV1Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	createOpts := databaseBackupV1CreateOpts{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		Instance:       d.Get("instance_id").(string),
+		ParentID:       d.Get("parent_id").(string),
+		SwiftContainer: d.Get("swift_container").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_db_backup_v1 create options: %#v", createOpts)
+
+	backup, err := databaseBackupV1Create(ctx, databaseV1Client, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_db_backup_v1: %s", err)
+	}
+
+	d.SetId(backup.ID)
+
+	log.Printf("[DEBUG] Waiting for openstack_db_backup_v1 %s to complete", backup.ID)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"NEW", "BUILDING", "SAVING"},
+		Target:     []string{"COMPLETED"},
+		Refresh:    databaseBackupV1StateRefreshFunc(ctx, databaseV1Client, backup.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for openstack_db_backup_v1 %s to complete: %s", backup.ID, err)
+	}
+
+	return resourceDatabaseBackupV1Read(ctx, d, meta)
+}
+
+func resourceDatabaseBackupV1Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	databaseV1Client, err := config.DatabaseV1Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	backup, err := databaseBackupV1Get(ctx, databaseV1Client, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_db_backup_v1"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_db_backup_v1 %s: %#v", d.Id(), backup)
+
+	d.Set("name", backup.Name)
+	d.Set("description", backup.Description)
+	d.Set("instance_id", backup.InstanceID)
+	d.Set("parent_id", backup.ParentID)
+	d.Set("project_id", backup.ProjectID)
+	d.Set("status", backup.Status)
+	d.Set("size", backup.Size)
+	d.Set("location_ref", backup.LocationRef)
+	d.Set("datastore", flattenDatabaseBackupV1Datastore(backup))
+	d.Set("created", backup.Created)
+	d.Set("updated", backup.Updated)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceDatabaseBackupV1Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	databaseV1Client, err := config.DatabaseV1Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	err = databaseBackupV1Delete(ctx, databaseV1Client, d.Id()).ExtractErr()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_db_backup_v1"))
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"COMPLETED", "DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    databaseBackupV1StateRefreshFunc(ctx, databaseV1Client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for openstack_db_backup_v1 %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/instances"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDatabaseV1Backup_basic(t *testing.T) {
+	var backup databaseBackupV1
+
+	var instance instances.Instance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckDatabaseV1BackupDestroy(t.Context()),
+			testAccCheckDatabaseV1InstanceDestroy(t.Context()),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1BackupBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1BackupExists(t.Context(),
+						"openstack_db_backup_v1.backup_1", &backup),
+					resource.TestCheckResourceAttr(
+						"openstack_db_backup_v1.backup_1", "name", "backup_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_db_backup_v1.backup_1", "status", "COMPLETED"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_db_backup_v1.backup_1", "instance_id",
+						"openstack_db_instance_v1.instance_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_db_backup_v1.backup_1", "datastore.0.type", osDBDatastoreType),
+				),
+			},
+			{
+				Config: testAccDatabaseV1BackupRestore(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1InstanceExists(t.Context(),
+						"openstack_db_instance_v1.restored", &instance),
+					resource.TestCheckResourceAttrPair(
+						"openstack_db_instance_v1.restored", "backup_id",
+						"openstack_db_backup_v1.backup_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDatabaseV1BackupExists(ctx context.Context, n string, backup *databaseBackupV1) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		databaseV1Client, err := config.DatabaseV1Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack database client: %w", err)
+		}
+
+		found, err := databaseBackupV1Get(ctx, databaseV1Client, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Backup not found")
+		}
+
+		*backup = *found
+
+		return nil
+	}
+}
+
+func testAccCheckDatabaseV1BackupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		databaseV1Client, err := config.DatabaseV1Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack database client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_db_backup_v1" {
+				continue
+			}
+
+			_, err := databaseBackupV1Get(ctx, databaseV1Client, rs.Primary.ID).Extract()
+			if err == nil {
+				return errors.New("Backup still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDatabaseV1BackupBasic() string {
+	return fmt.Sprintf(`
+resource "openstack_db_instance_v1" "instance_1" {
+  name = "instance_1"
+  size = 10
+
+  datastore {
+    version = "%[1]s"
+    type    = "%[2]s"
+  }
+
+  network {
+    uuid = "%[3]s"
+  }
+}
+
+resource "openstack_db_backup_v1" "backup_1" {
+  name        = "backup_1"
+  description = "test backup"
+  instance_id = openstack_db_instance_v1.instance_1.id
+}
+`, osDBDatastoreVersion, osDBDatastoreType, osNetworkID)
+}
+
+func testAccDatabaseV1BackupRestore() string {
+	return fmt.Sprintf(`
+%[4]s
+
+resource "openstack_db_instance_v1" "restored" {
+  name      = "restored"
+  size      = 10
+  backup_id = openstack_db_backup_v1.backup_1.id
+
+  datastore {
+    version = "%[1]s"
+    type    = "%[2]s"
+  }
+
+  network {
+    uuid = "%[3]s"
+  }
+}
+`, osDBDatastoreVersion, osDBDatastoreType, osNetworkID, testAccDatabaseV1BackupBasic())
+}
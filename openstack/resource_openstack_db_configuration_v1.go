@@ -22,6 +22,8 @@ func resourceDatabaseConfigurationV1() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: resourceDatabaseConfigurationV1CustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -87,6 +89,17 @@ func resourceDatabaseConfigurationV1() *schema.Resource {
 					},
 				},
 			},
+
+			"restart_required": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"restart_required_parameters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -164,6 +177,18 @@ func resourceDatabaseConfigurationV1Read(ctx context.Context, d *schema.Resource
 	d.Set("description", cgroup.Description)
 	d.Set("region", GetRegion(d, config))
 
+	var restartRequired []string
+
+	params, err := databaseConfigurationV1Parameters(ctx, databaseV1Client, cgroup.DatastoreName, cgroup.DatastoreVersionName)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to retrieve %s %s configuration parameters: %s", cgroup.DatastoreName, cgroup.DatastoreVersionName, err)
+	} else {
+		restartRequired = databaseConfigurationV1RestartRequired(params, cgroup.Values)
+	}
+
+	d.Set("restart_required", len(restartRequired) > 0)
+	d.Set("restart_required_parameters", restartRequired)
+
 	return nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/configurations"
@@ -34,12 +35,32 @@ func TestAccDatabaseV1Configuration_basic(t *testing.T) {
 						"openstack_db_configuration_v1.basic", "configuration.2.name", "max_connections"),
 					resource.TestCheckResourceAttr(
 						"openstack_db_configuration_v1.basic", "configuration.2.value", "200"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_db_configuration_v1.basic", "restart_required"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccDatabaseV1Configuration_invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDatabaseV1ConfigurationDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDatabaseV1ConfigurationInvalid(),
+				ExpectError: regexp.MustCompile(`configuration parameter "max_connections" must be an integer`),
+			},
+		},
+	})
+}
+
 func testAccCheckDatabaseV1ConfigurationExists(ctx context.Context, n string, configuration *configurations.Config) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -125,3 +146,22 @@ resource "openstack_db_configuration_v1" "basic" {
 }
 `, osDBDatastoreVersion, osDBDatastoreType)
 }
+
+func testAccDatabaseV1ConfigurationInvalid() string {
+	return fmt.Sprintf(`
+resource "openstack_db_configuration_v1" "invalid" {
+  name        = "invalid"
+  description = "test"
+
+  datastore {
+    version = "%s"
+    type    = "%s"
+  }
+
+  configuration {
+    name  = "max_connections"
+    value = "many"
+  }
+}
+`, osDBDatastoreVersion, osDBDatastoreType)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/instances"
 	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/users"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -22,9 +23,22 @@ func resourceDatabaseInstanceV1() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.All(
+			// Trove can only extend the volume of an instance.
+			customdiff.ForceNewIfChange("size", func(_ context.Context, o, n, _ any) bool {
+				return n.(int) < o.(int)
+			}),
+			// An instance can't be turned into a replica. Removing
+			// replica_of promotes the replica instead.
+			customdiff.ForceNewIfChange("replica_of", func(_ context.Context, _, n, _ any) bool {
+				return n.(string) != ""
+			}),
+		),
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -41,7 +55,6 @@ func resourceDatabaseInstanceV1() *schema.Resource {
 			"flavor_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Computed:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OS_FLAVOR_ID", nil),
 			},
@@ -50,7 +63,6 @@ func resourceDatabaseInstanceV1() *schema.Resource {
 				Type:         schema.TypeInt,
 				Required:     true,
 				RequiredWith: []string{"volume_type"},
-				ForceNew:     true,
 			},
 
 			"volume_type": {
@@ -69,7 +81,6 @@ func resourceDatabaseInstanceV1() *schema.Resource {
 						"version": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"type": {
 							Type:     schema.TypeString,
@@ -167,6 +178,17 @@ func resourceDatabaseInstanceV1() *schema.Resource {
 				},
 			},
 
+			"replica_of": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"backup_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
 			"configuration_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -195,10 +217,14 @@ func resourceDatabaseInstanceV1Create(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("Error creating OpenStack database client: %s", err)
 	}
 
-	createOpts := &instances.CreateOpts{
-		FlavorRef: d.Get("flavor_id").(string),
-		Name:      d.Get("name").(string),
-		Size:      d.Get("size").(int),
+	createOpts := &databaseInstanceV1CreateOpts{
+		CreateOpts: instances.CreateOpts{
+			FlavorRef: d.Get("flavor_id").(string),
+			Name:      d.Get("name").(string),
+			Size:      d.Get("size").(int),
+		},
+		ReplicaOf: d.Get("replica_of").(string),
+		BackupID:  d.Get("backup_id").(string),
 	}
 
 	// volume_type
@@ -286,19 +312,35 @@ func resourceDatabaseInstanceV1Read(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("Error creating OpenStack database client: %s", err)
 	}
 
-	instance, err := instances.Get(ctx, databaseV1Client, d.Id()).Extract()
+	r := instances.Get(ctx, databaseV1Client, d.Id())
+
+	instance, err := r.Extract()
 	if err != nil {
 		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_db_instance_v1"))
 	}
 
 	log.Printf("[DEBUG] Retrieved openstack_db_instance_v1 %s: %#v", d.Id(), instance)
 
+	version := databaseInstanceV1DatastoreVersion(ctx, databaseV1Client, instance, d.Get("datastore.0.version").(string))
+
 	d.Set("name", instance.Name)
-	d.Set("flavor_id", instance.Flavor)
-	d.Set("datastore", instance.Datastore)
+	d.Set("flavor_id", instance.Flavor.ID)
+	d.Set("size", instance.Volume.Size)
+	d.Set("datastore", flattenDatabaseInstanceV1Datastore(instance, version))
 	d.Set("addresses", instance.IP)
 	d.Set("region", GetRegion(d, config))
 
+	// A former replication source becomes a replica of the promoted
+	// instance, so only instances created as replicas track replica_of.
+	if d.Get("replica_of").(string) != "" {
+		replicaOf, err := extractDatabaseInstanceV1ReplicaOf(r)
+		if err != nil {
+			return diag.Errorf("Error retrieving replica_of of openstack_db_instance_v1 %s: %s", d.Id(), err)
+		}
+
+		d.Set("replica_of", replicaOf)
+	}
+
 	return nil
 }
 
@@ -310,6 +352,87 @@ func resourceDatabaseInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("Error creating OpenStack database client: %s", err)
 	}
 
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	if d.HasChange("replica_of") {
+		updatedAt, err := databaseInstanceV1UpdatedAt(ctx, databaseV1Client, d.Id())
+		if err != nil {
+			return diag.Errorf("Error retrieving openstack_db_instance_v1 %s: %s", d.Id(), err)
+		}
+
+		log.Printf("[DEBUG] Promoting openstack_db_instance_v1 %s to replica source", d.Id())
+
+		err = databaseInstanceV1PromoteToReplicaSource(ctx, databaseV1Client, d.Id()).ExtractErr()
+		if err != nil {
+			return diag.Errorf("Error promoting openstack_db_instance_v1 %s to replica source: %s", d.Id(), err)
+		}
+
+		err = databaseInstanceV1WaitForActive(ctx, databaseV1Client, d.Id(), updatedAt, timeout)
+		if err != nil {
+			return diag.Errorf("Error waiting for openstack_db_instance_v1 %s to be promoted: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("flavor_id") {
+		updatedAt, err := databaseInstanceV1UpdatedAt(ctx, databaseV1Client, d.Id())
+		if err != nil {
+			return diag.Errorf("Error retrieving openstack_db_instance_v1 %s: %s", d.Id(), err)
+		}
+
+		flavorID := d.Get("flavor_id").(string)
+		log.Printf("[DEBUG] Resizing openstack_db_instance_v1 %s to flavor %s", d.Id(), flavorID)
+
+		err = instances.Resize(ctx, databaseV1Client, d.Id(), flavorID).ExtractErr()
+		if err != nil {
+			return diag.Errorf("Error resizing openstack_db_instance_v1 %s to flavor %s: %s", d.Id(), flavorID, err)
+		}
+
+		err = databaseInstanceV1WaitForActive(ctx, databaseV1Client, d.Id(), updatedAt, timeout)
+		if err != nil {
+			return diag.Errorf("Error waiting for openstack_db_instance_v1 %s to be resized: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("size") {
+		updatedAt, err := databaseInstanceV1UpdatedAt(ctx, databaseV1Client, d.Id())
+		if err != nil {
+			return diag.Errorf("Error retrieving openstack_db_instance_v1 %s: %s", d.Id(), err)
+		}
+
+		size := d.Get("size").(int)
+		log.Printf("[DEBUG] Resizing openstack_db_instance_v1 %s volume to %d GB", d.Id(), size)
+
+		err = instances.ResizeVolume(ctx, databaseV1Client, d.Id(), size).ExtractErr()
+		if err != nil {
+			return diag.Errorf("Error resizing openstack_db_instance_v1 %s volume to %d GB: %s", d.Id(), size, err)
+		}
+
+		err = databaseInstanceV1WaitForActive(ctx, databaseV1Client, d.Id(), updatedAt, timeout)
+		if err != nil {
+			return diag.Errorf("Error waiting for openstack_db_instance_v1 %s volume to be resized: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("datastore.0.version") {
+		updatedAt, err := databaseInstanceV1UpdatedAt(ctx, databaseV1Client, d.Id())
+		if err != nil {
+			return diag.Errorf("Error retrieving openstack_db_instance_v1 %s: %s", d.Id(), err)
+		}
+
+		version := d.Get("datastore.0.version").(string)
+		log.Printf("[DEBUG] Upgrading openstack_db_instance_v1 %s datastore to version %s", d.Id(), version)
+
+		err = databaseInstanceV1UpgradeDatastore(ctx, databaseV1Client, d.Id(), version).ExtractErr()
+		if err != nil {
+			return diag.Errorf("Error upgrading openstack_db_instance_v1 %s datastore to version %s: %s", d.Id(), version, err)
+		}
+
+		err = databaseInstanceV1WaitForActive(ctx, databaseV1Client, d.Id(), updatedAt, timeout)
+		if err != nil {
+			return diag.Errorf("Error waiting for openstack_db_instance_v1 %s datastore to be upgraded: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("configuration_id") {
 		o, n := d.GetChange("configuration_id")
 
@@ -61,6 +61,81 @@ func TestAccDatabaseV1Instance_basic(t *testing.T) {
 	})
 }
 
+func TestAccDatabaseV1Instance_resize(t *testing.T) {
+	var instance instances.Instance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDatabaseV1InstanceDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1InstanceResize(10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1InstanceExists(t.Context(),
+						"openstack_db_instance_v1.resize", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_db_instance_v1.resize", "size", "10"),
+				),
+			},
+			{
+				Config: testAccDatabaseV1InstanceResize(12),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1InstanceExists(t.Context(),
+						"openstack_db_instance_v1.resize", &instance),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_db_instance_v1.resize", "id", &instance.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_db_instance_v1.resize", "size", "12"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabaseV1Instance_replica(t *testing.T) {
+	var primary, replica instances.Instance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDatabaseV1InstanceDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1InstanceReplica(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1InstanceExists(t.Context(),
+						"openstack_db_instance_v1.primary", &primary),
+					testAccCheckDatabaseV1InstanceExists(t.Context(),
+						"openstack_db_instance_v1.replica", &replica),
+					resource.TestCheckResourceAttrPair(
+						"openstack_db_instance_v1.replica", "replica_of",
+						"openstack_db_instance_v1.primary", "id"),
+				),
+			},
+			{
+				Config: testAccDatabaseV1InstanceReplica(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1InstanceExists(t.Context(),
+						"openstack_db_instance_v1.replica", &replica),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_db_instance_v1.replica", "id", &replica.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_db_instance_v1.replica", "replica_of", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDatabaseV1InstanceExists(ctx context.Context, n string, instance *instances.Instance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -183,3 +258,59 @@ resource "openstack_db_configuration_v1" "basic" {
 }
 `, osDBDatastoreVersion, osDBDatastoreType, osNetworkID)
 }
+
+func testAccDatabaseV1InstanceResize(size int) string {
+	return fmt.Sprintf(`
+resource "openstack_db_instance_v1" "resize" {
+  name = "resize"
+  size = %[4]d
+
+  datastore {
+    version = "%[1]s"
+    type    = "%[2]s"
+  }
+
+  network {
+    uuid = "%[3]s"
+  }
+}
+`, osDBDatastoreVersion, osDBDatastoreType, osNetworkID, size)
+}
+
+func testAccDatabaseV1InstanceReplica(isReplica bool) string {
+	replicaOf := "null"
+	if isReplica {
+		replicaOf = "openstack_db_instance_v1.primary.id"
+	}
+
+	return fmt.Sprintf(`
+resource "openstack_db_instance_v1" "primary" {
+  name = "primary"
+  size = 10
+
+  datastore {
+    version = "%[1]s"
+    type    = "%[2]s"
+  }
+
+  network {
+    uuid = "%[3]s"
+  }
+}
+
+resource "openstack_db_instance_v1" "replica" {
+  name       = "replica"
+  size       = 10
+  replica_of = %[4]s
+
+  datastore {
+    version = "%[1]s"
+    type    = "%[2]s"
+  }
+
+  network {
+    uuid = "%[3]s"
+  }
+}
+`, osDBDatastoreVersion, osDBDatastoreType, osNetworkID, replicaOf)
+}
@@ -0,0 +1,181 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIdentityDomainConfigV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityDomainConfigV3Create,
+		ReadContext:   resourceIdentityDomainConfigV3Read,
+		UpdateContext: resourceIdentityDomainConfigV3Update,
+		DeleteContext: resourceIdentityDomainConfigV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIdentityDomainConfigV3Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"domain_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"identity": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"ldap": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"ldap_password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceIdentityDomainConfigV3Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	domainID := d.Get("domain_id").(string)
+	domainConfig := expandIdentityDomainConfigV3(
+		d.Get("identity").(map[string]any),
+		d.Get("ldap").(map[string]any),
+		d.Get("ldap_password").(string),
+	)
+
+	log.Printf("[DEBUG] Creating openstack_identity_domain_config_v3 for domain %s", domainID)
+
+	_, err = identityDomainConfigV3Create(ctx, identityClient, domainID, domainConfig).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_identity_domain_config_v3: %s", err)
+	}
+
+	d.SetId(domainID)
+
+	return resourceIdentityDomainConfigV3Read(ctx, d, meta)
+}
+
+func resourceIdentityDomainConfigV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	domainConfig, err := identityDomainConfigV3Get(ctx, identityClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_identity_domain_config_v3"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_identity_domain_config_v3 %s", d.Id())
+
+	// The LDAP password is never returned by Keystone.
+	ldap := flattenIdentityDomainConfigV3Group(domainConfig["ldap"])
+	delete(ldap, "password")
+
+	d.Set("domain_id", d.Id())
+	d.Set("identity", flattenIdentityDomainConfigV3Group(domainConfig["identity"]))
+	d.Set("ldap", ldap)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceIdentityDomainConfigV3Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	// Options removed from the configuration must be deleted explicitly,
+	// since an update only merges the given options.
+	for _, group := range getIdentityDomainConfigV3Groups() {
+		if !d.HasChange(group) {
+			continue
+		}
+
+		o, n := d.GetChange(group)
+		for _, option := range identityDomainConfigV3RemovedOptions(o.(map[string]any), n.(map[string]any)) {
+			log.Printf("[DEBUG] Deleting openstack_identity_domain_config_v3 %s option %s/%s", d.Id(), group, option)
+
+			err := identityDomainConfigV3Delete(ctx, identityClient, d.Id(), group, option).ExtractErr()
+			if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return diag.Errorf("Error deleting openstack_identity_domain_config_v3 %s option %s/%s: %s", d.Id(), group, option, err)
+			}
+		}
+	}
+
+	if d.HasChange("ldap_password") && d.Get("ldap_password").(string) == "" {
+		err := identityDomainConfigV3Delete(ctx, identityClient, d.Id(), "ldap", "password").ExtractErr()
+		if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return diag.Errorf("Error deleting openstack_identity_domain_config_v3 %s LDAP password: %s", d.Id(), err)
+		}
+	}
+
+	domainConfig := expandIdentityDomainConfigV3(
+		d.Get("identity").(map[string]any),
+		d.Get("ldap").(map[string]any),
+		d.Get("ldap_password").(string),
+	)
+
+	if len(domainConfig) > 0 {
+		_, err = identityDomainConfigV3Update(ctx, identityClient, d.Id(), domainConfig).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_identity_domain_config_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIdentityDomainConfigV3Read(ctx, d, meta)
+}
+
+func resourceIdentityDomainConfigV3Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	err = identityDomainConfigV3Delete(ctx, identityClient, d.Id()).ExtractErr()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_identity_domain_config_v3"))
+	}
+
+	return nil
+}
+
+func resourceIdentityDomainConfigV3Import(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	d.Set("domain_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
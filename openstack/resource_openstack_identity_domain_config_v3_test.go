@@ -0,0 +1,157 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIdentityV3DomainConfig_basic(t *testing.T) {
+	domainName := "ACCPTTEST-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckDomainConfig(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIdentityV3DomainConfigDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3DomainConfigBasic(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3DomainConfigExists(t.Context(), "openstack_identity_domain_config_v3.config_1"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_identity_domain_config_v3.config_1", "domain_id",
+						"openstack_identity_domain_v3.domain_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_domain_config_v3.config_1", "identity.driver", "ldap"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_domain_config_v3.config_1", "ldap.url", "ldap://ldap.example.com"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_domain_config_v3.config_1", "ldap.user_tree_dn", "ou=Users,dc=example,dc=com"),
+				),
+			},
+			{
+				Config: testAccIdentityV3DomainConfigUpdate(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3DomainConfigExists(t.Context(), "openstack_identity_domain_config_v3.config_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_domain_config_v3.config_1", "ldap.url", "ldaps://ldap.example.com"),
+					resource.TestCheckNoResourceAttr(
+						"openstack_identity_domain_config_v3.config_1", "ldap.user_tree_dn"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_domain_config_v3.config_1", "ldap.query_scope", "sub"),
+				),
+			},
+			{
+				ResourceName:            "openstack_identity_domain_config_v3.config_1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ldap_password"},
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3DomainConfigDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		identityClient, err := config.IdentityV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_identity_domain_config_v3" {
+				continue
+			}
+
+			_, err := identityDomainConfigV3Get(ctx, identityClient, rs.Primary.ID).Extract()
+			if err == nil {
+				return errors.New("Domain config still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIdentityV3DomainConfigExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		identityClient, err := config.IdentityV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %w", err)
+		}
+
+		_, err = identityDomainConfigV3Get(ctx, identityClient, rs.Primary.ID).Extract()
+
+		return err
+	}
+}
+
+func testAccIdentityV3DomainConfigBasic(domainName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_domain_v3" "domain_1" {
+  name = "%s"
+}
+
+resource "openstack_identity_domain_config_v3" "config_1" {
+  domain_id = openstack_identity_domain_v3.domain_1.id
+
+  identity = {
+    driver = "ldap"
+  }
+
+  ldap = {
+    url          = "ldap://ldap.example.com"
+    user         = "cn=admin,dc=example,dc=com"
+    user_tree_dn = "ou=Users,dc=example,dc=com"
+  }
+
+  ldap_password = "secret"
+}
+`, domainName)
+}
+
+func testAccIdentityV3DomainConfigUpdate(domainName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_domain_v3" "domain_1" {
+  name = "%s"
+}
+
+resource "openstack_identity_domain_config_v3" "config_1" {
+  domain_id = openstack_identity_domain_v3.domain_1.id
+
+  identity = {
+    driver = "ldap"
+  }
+
+  ldap = {
+    url         = "ldaps://ldap.example.com"
+    user        = "cn=admin,dc=example,dc=com"
+    query_scope = "sub"
+  }
+
+  ldap_password = "secret"
+}
+`, domainName)
+}
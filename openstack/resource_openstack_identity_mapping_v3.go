@@ -0,0 +1,143 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/federation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+func resourceIdentityMappingV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityMappingV3Create,
+		ReadContext:   resourceIdentityMappingV3Read,
+		UpdateContext: resourceIdentityMappingV3Update,
+		DeleteContext: resourceIdentityMappingV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// The mapping rules language is quite rich, therefore we
+			// stay close to the API and make it type String. The user
+			// can use jsonencode to pass it properly.
+			"rules": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateIdentityMappingV3Rules,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				StateFunc: func(v any) string {
+					json, _ := structure.NormalizeJsonString(v)
+
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceIdentityMappingV3Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+
+	createOpts, err := expandIdentityMappingV3Rules(d.Get("rules").(string))
+	if err != nil {
+		return diag.Errorf("Error creating openstack_identity_mapping_v3: %s", err)
+	}
+
+	log.Printf("[DEBUG] openstack_identity_mapping_v3 create options: %#v", createOpts)
+
+	_, err = federation.CreateMapping(ctx, identityClient, name, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_identity_mapping_v3: %s", err)
+	}
+
+	d.SetId(name)
+
+	return resourceIdentityMappingV3Read(ctx, d, meta)
+}
+
+func resourceIdentityMappingV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	rules, err := extractIdentityMappingV3Rules(federation.GetMapping(ctx, identityClient, d.Id()))
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_identity_mapping_v3"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_identity_mapping_v3 %s: %s", d.Id(), rules)
+
+	d.Set("name", d.Id())
+	d.Set("rules", rules)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceIdentityMappingV3Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	if d.HasChange("rules") {
+		updateOpts, err := expandIdentityMappingV3Rules(d.Get("rules").(string))
+		if err != nil {
+			return diag.Errorf("Error updating openstack_identity_mapping_v3 %s: %s", d.Id(), err)
+		}
+
+		log.Printf("[DEBUG] openstack_identity_mapping_v3 %s update options: %#v", d.Id(), updateOpts)
+
+		_, err = federation.UpdateMapping(ctx, identityClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_identity_mapping_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIdentityMappingV3Read(ctx, d, meta)
+}
+
+func resourceIdentityMappingV3Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	err = federation.DeleteMapping(ctx, identityClient, d.Id()).ExtractErr()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_identity_mapping_v3"))
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIdentityProjectRoleAssignmentsV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityProjectRoleAssignmentsV3Create,
+		ReadContext:   resourceIdentityProjectRoleAssignmentsV3Read,
+		UpdateContext: resourceIdentityProjectRoleAssignmentsV3Update,
+		DeleteContext: resourceIdentityProjectRoleAssignmentsV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIdentityProjectRoleAssignmentsV3Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"assignment": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"group_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"role_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"inherited": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIdentityProjectRoleAssignmentsV3Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	projectID := d.Get("project_id").(string)
+
+	if err := resourceIdentityProjectRoleAssignmentsV3Apply(ctx, d, meta, projectID); err != nil {
+		return diag.Errorf("Error creating openstack_identity_project_role_assignments_v3: %s", err)
+	}
+
+	d.SetId(projectID)
+
+	return resourceIdentityProjectRoleAssignmentsV3Read(ctx, d, meta)
+}
+
+func resourceIdentityProjectRoleAssignmentsV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	assignments, err := identityProjectRoleAssignmentsV3List(ctx, identityClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_identity_project_role_assignments_v3"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_identity_project_role_assignments_v3 %s: %#v", d.Id(), assignments)
+
+	d.Set("project_id", d.Id())
+	d.Set("assignment", flattenIdentityProjectRoleAssignmentsV3(assignments))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceIdentityProjectRoleAssignmentsV3Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if d.HasChange("assignment") {
+		if err := resourceIdentityProjectRoleAssignmentsV3Apply(ctx, d, meta, d.Id()); err != nil {
+			return diag.Errorf("Error updating openstack_identity_project_role_assignments_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIdentityProjectRoleAssignmentsV3Read(ctx, d, meta)
+}
+
+func resourceIdentityProjectRoleAssignmentsV3Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	assignments, err := expandIdentityProjectRoleAssignmentsV3(d.Get("assignment").(*schema.Set).List())
+	if err != nil {
+		return diag.Errorf("Error deleting openstack_identity_project_role_assignments_v3 %s: %s", d.Id(), err)
+	}
+
+	for _, a := range assignments {
+		log.Printf("[DEBUG] Removing openstack_identity_project_role_assignments_v3 %s assignment: %#v", d.Id(), a)
+
+		err := identityProjectRoleAssignmentV3Unassign(ctx, identityClient, d.Id(), a)
+		if err != nil {
+			return diag.Errorf("Error deleting openstack_identity_project_role_assignments_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func resourceIdentityProjectRoleAssignmentsV3Import(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	d.Set("project_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceIdentityProjectRoleAssignmentsV3Apply makes the role assignments on
+// the project match the configured ones. Assignments which are not
+// configured are removed.
+func resourceIdentityProjectRoleAssignmentsV3Apply(ctx context.Context, d *schema.ResourceData, meta any, projectID string) error {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return err
+	}
+
+	desired, err := expandIdentityProjectRoleAssignmentsV3(d.Get("assignment").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	current, err := identityProjectRoleAssignmentsV3List(ctx, identityClient, projectID)
+	if err != nil {
+		return err
+	}
+
+	toAssign, toUnassign := diffIdentityProjectRoleAssignmentsV3(current, desired)
+
+	// Assign first, so that a role which is moved from a group to a user
+	// remains available during the update.
+	for _, a := range toAssign {
+		log.Printf("[DEBUG] Adding openstack_identity_project_role_assignments_v3 %s assignment: %#v", projectID, a)
+
+		if err := identityProjectRoleAssignmentV3Assign(ctx, identityClient, projectID, a); err != nil {
+			return err
+		}
+	}
+
+	for _, a := range toUnassign {
+		log.Printf("[DEBUG] Removing openstack_identity_project_role_assignments_v3 %s assignment: %#v", projectID, a)
+
+		if err := identityProjectRoleAssignmentV3Unassign(ctx, identityClient, projectID, a); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,243 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIdentityV3ProjectRoleAssignments_basic(t *testing.T) {
+	name := "ACCPTTEST-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIdentityV3ProjectRoleAssignmentsDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3ProjectRoleAssignmentsBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProjectRoleAssignmentsCount(t.Context(),
+						"openstack_identity_project_role_assignments_v3.assignments_1", 2),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_project_role_assignments_v3.assignments_1", "assignment.#", "2"),
+				),
+			},
+			{
+				Config: testAccIdentityV3ProjectRoleAssignmentsUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProjectRoleAssignmentsCount(t.Context(),
+						"openstack_identity_project_role_assignments_v3.assignments_1", 2),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_project_role_assignments_v3.assignments_1", "assignment.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"openstack_identity_project_role_assignments_v3.assignments_1", "assignment.*", map[string]string{
+							"inherited": "true",
+						}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityV3ProjectRoleAssignments_removeUndeclared(t *testing.T) {
+	var projectID, userID, roleID string
+
+	name := "ACCPTTEST-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIdentityV3ProjectRoleAssignmentsDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3ProjectRoleAssignmentsGroupOnly(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProjectRoleAssignmentsSaveAttr("openstack_identity_project_v3.project_1", "id", &projectID),
+					testAccCheckIdentityV3ProjectRoleAssignmentsSaveAttr("openstack_identity_user_v3.user_1", "id", &userID),
+					testAccCheckIdentityV3ProjectRoleAssignmentsSaveAttr("openstack_identity_role_v3.role_1", "id", &roleID),
+				),
+			},
+			{
+				// The user role assignment is made outside of Terraform
+				// and must be removed.
+				PreConfig: func() {
+					config := testAccProvider.Meta().(*Config)
+
+					identityClient, err := config.IdentityV3Client(t.Context(), osRegionName)
+					if err != nil {
+						t.Fatalf("Error creating OpenStack identity client: %s", err)
+					}
+
+					err = identityProjectRoleAssignmentV3Assign(t.Context(), identityClient, projectID,
+						identityProjectRoleAssignmentV3{UserID: userID, RoleID: roleID})
+					if err != nil {
+						t.Fatalf("Error assigning role: %s", err)
+					}
+				},
+				Config: testAccIdentityV3ProjectRoleAssignmentsGroupOnly(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProjectRoleAssignmentsCount(t.Context(),
+						"openstack_identity_project_role_assignments_v3.assignments_1", 1),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_project_role_assignments_v3.assignments_1", "assignment.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3ProjectRoleAssignmentsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		identityClient, err := config.IdentityV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_identity_project_role_assignments_v3" {
+				continue
+			}
+
+			assignments, err := identityProjectRoleAssignmentsV3List(ctx, identityClient, rs.Primary.ID)
+			if err == nil && len(assignments) > 0 {
+				return errors.New("Project role assignments still exist")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIdentityV3ProjectRoleAssignmentsSaveAttr(n, key string, value *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		*value = rs.Primary.Attributes[key]
+
+		return nil
+	}
+}
+
+func testAccCheckIdentityV3ProjectRoleAssignmentsCount(ctx context.Context, n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		identityClient, err := config.IdentityV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %w", err)
+		}
+
+		assignments, err := identityProjectRoleAssignmentsV3List(ctx, identityClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(assignments) != count {
+			return fmt.Errorf("Expected %d project role assignments, got %d", count, len(assignments))
+		}
+
+		return nil
+	}
+}
+
+func testAccIdentityV3ProjectRoleAssignmentsBase(name string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_project_v3" "project_1" {
+  name = "%[1]s"
+}
+
+resource "openstack_identity_user_v3" "user_1" {
+  name = "%[1]s"
+}
+
+resource "openstack_identity_group_v3" "group_1" {
+  name = "%[1]s"
+}
+
+resource "openstack_identity_role_v3" "role_1" {
+  name = "%[1]s"
+}
+`, name)
+}
+
+func testAccIdentityV3ProjectRoleAssignmentsBasic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_identity_project_role_assignments_v3" "assignments_1" {
+  project_id = openstack_identity_project_v3.project_1.id
+
+  assignment {
+    user_id = openstack_identity_user_v3.user_1.id
+    role_id = openstack_identity_role_v3.role_1.id
+  }
+
+  assignment {
+    group_id = openstack_identity_group_v3.group_1.id
+    role_id  = openstack_identity_role_v3.role_1.id
+  }
+}
+`, testAccIdentityV3ProjectRoleAssignmentsBase(name))
+}
+
+func testAccIdentityV3ProjectRoleAssignmentsUpdate(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_identity_project_role_assignments_v3" "assignments_1" {
+  project_id = openstack_identity_project_v3.project_1.id
+
+  assignment {
+    user_id = openstack_identity_user_v3.user_1.id
+    role_id = openstack_identity_role_v3.role_1.id
+  }
+
+  assignment {
+    group_id  = openstack_identity_group_v3.group_1.id
+    role_id   = openstack_identity_role_v3.role_1.id
+    inherited = true
+  }
+}
+`, testAccIdentityV3ProjectRoleAssignmentsBase(name))
+}
+
+func testAccIdentityV3ProjectRoleAssignmentsGroupOnly(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_identity_project_role_assignments_v3" "assignments_1" {
+  project_id = openstack_identity_project_v3.project_1.id
+
+  assignment {
+    group_id = openstack_identity_group_v3.group_1.id
+    role_id  = openstack_identity_role_v3.role_1.id
+  }
+}
+`, testAccIdentityV3ProjectRoleAssignmentsBase(name))
+}
@@ -102,7 +102,7 @@ func resourceIdentityProjectV3Create(ctx context.Context, d *schema.ResourceData
 
 	project, err := projects.Create(ctx, identityClient, createOpts).Extract()
 	if err != nil {
-		return diag.Errorf("Error creating openstack_identity_project_v3: %s", err)
+		return diagOpenStackErrorf(err, "Error creating openstack_identity_project_v3")
 	}
 
 	d.SetId(project.ID)
@@ -197,7 +197,7 @@ func resourceIdentityProjectV3Update(ctx context.Context, d *schema.ResourceData
 	if hasChange {
 		_, err := projects.Update(ctx, identityClient, d.Id(), updateOpts).Extract()
 		if err != nil {
-			return diag.Errorf("Error updating openstack_identity_project_v3 %s: %s", d.Id(), err)
+			return diagOpenStackErrorf(err, "Error updating openstack_identity_project_v3 %s", d.Id())
 		}
 	}
 
@@ -0,0 +1,161 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIdentityProtocolV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityProtocolV3Create,
+		ReadContext:   resourceIdentityProtocolV3Read,
+		UpdateContext: resourceIdentityProtocolV3Update,
+		DeleteContext: resourceIdentityProtocolV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"identity_provider_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"mapping_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"remote_id_attribute": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceIdentityProtocolV3Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	idpID := d.Get("identity_provider_id").(string)
+	name := d.Get("name").(string)
+	createOpts := identityProtocolV3CreateOpts{
+		MappingID:         d.Get("mapping_id").(string),
+		RemoteIDAttribute: d.Get("remote_id_attribute").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_identity_protocol_v3 create options: %#v", createOpts)
+
+	_, err = identityProtocolV3Create(ctx, identityClient, idpID, name, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_identity_protocol_v3: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", idpID, name))
+
+	return resourceIdentityProtocolV3Read(ctx, d, meta)
+}
+
+func resourceIdentityProtocolV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	idpID, name, err := parsePairedIDs(d.Id(), "openstack_identity_protocol_v3")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	protocol, err := identityProtocolV3Get(ctx, identityClient, idpID, name).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_identity_protocol_v3"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_identity_protocol_v3 %s: %#v", d.Id(), protocol)
+
+	d.Set("name", name)
+	d.Set("identity_provider_id", idpID)
+	d.Set("mapping_id", protocol.MappingID)
+	d.Set("remote_id_attribute", protocol.RemoteIDAttribute)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceIdentityProtocolV3Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	idpID, name, err := parsePairedIDs(d.Id(), "openstack_identity_protocol_v3")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("mapping_id", "remote_id_attribute") {
+		updateOpts := identityProtocolV3CreateOpts{
+			MappingID:         d.Get("mapping_id").(string),
+			RemoteIDAttribute: d.Get("remote_id_attribute").(string),
+		}
+
+		log.Printf("[DEBUG] openstack_identity_protocol_v3 %s update options: %#v", d.Id(), updateOpts)
+
+		_, err = identityProtocolV3Update(ctx, identityClient, idpID, name, updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_identity_protocol_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIdentityProtocolV3Read(ctx, d, meta)
+}
+
+func resourceIdentityProtocolV3Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	idpID, name, err := parsePairedIDs(d.Id(), "openstack_identity_protocol_v3")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = identityProtocolV3Delete(ctx, identityClient, idpID, name).ExtractErr()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_identity_protocol_v3"))
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIdentityV3Protocol_basic(t *testing.T) {
+	name := "ACCPTTEST-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIdentityV3ProtocolDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3ProtocolBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProtocolExists(t.Context(), "openstack_identity_protocol_v3.protocol_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_protocol_v3.protocol_1", "name", "openid"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_identity_protocol_v3.protocol_1", "identity_provider_id",
+						"openstack_identity_provider_v3.idp_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_identity_protocol_v3.protocol_1", "mapping_id",
+						"openstack_identity_mapping_v3.mapping_1", "id"),
+				),
+			},
+			{
+				Config: testAccIdentityV3ProtocolUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProtocolExists(t.Context(), "openstack_identity_protocol_v3.protocol_1"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_identity_protocol_v3.protocol_1", "mapping_id",
+						"openstack_identity_mapping_v3.mapping_2", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_protocol_v3.protocol_1", "remote_id_attribute", "HTTP_OIDC_ISS"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3ProtocolDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		identityClient, err := config.IdentityV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_identity_protocol_v3" {
+				continue
+			}
+
+			idpID, protocolID, err := parsePairedIDs(rs.Primary.ID, "openstack_identity_protocol_v3")
+			if err != nil {
+				return err
+			}
+
+			_, err = identityProtocolV3Get(ctx, identityClient, idpID, protocolID).Extract()
+			if err == nil {
+				return errors.New("Protocol still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIdentityV3ProtocolExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		identityClient, err := config.IdentityV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %w", err)
+		}
+
+		idpID, protocolID, err := parsePairedIDs(rs.Primary.ID, "openstack_identity_protocol_v3")
+		if err != nil {
+			return err
+		}
+
+		found, err := identityProtocolV3Get(ctx, identityClient, idpID, protocolID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != protocolID {
+			return errors.New("Protocol not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccIdentityV3ProtocolBase(name string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_provider_v3" "idp_1" {
+      name       = "%s"
+      remote_ids = ["https://%s.example.com/realms/openstack"]
+    }
+
+    resource "openstack_identity_mapping_v3" "mapping_1" {
+      name  = "%s-1"
+      rules = jsonencode([
+        {
+          local  = [{ user = { name = "{0}" } }]
+          remote = [{ type = "OIDC-preferred_username" }]
+        }
+      ])
+    }
+
+    resource "openstack_identity_mapping_v3" "mapping_2" {
+      name  = "%s-2"
+      rules = jsonencode([
+        {
+          local  = [{ user = { name = "{0}" } }]
+          remote = [{ type = "OIDC-email" }]
+        }
+      ])
+    }
+  `, name, name, name, name)
+}
+
+func testAccIdentityV3ProtocolBasic(name string) string {
+	return fmt.Sprintf(`
+    %s
+
+    resource "openstack_identity_protocol_v3" "protocol_1" {
+      name                 = "openid"
+      identity_provider_id = openstack_identity_provider_v3.idp_1.id
+      mapping_id           = openstack_identity_mapping_v3.mapping_1.id
+    }
+  `, testAccIdentityV3ProtocolBase(name))
+}
+
+func testAccIdentityV3ProtocolUpdate(name string) string {
+	return fmt.Sprintf(`
+    %s
+
+    resource "openstack_identity_protocol_v3" "protocol_1" {
+      name                 = "openid"
+      identity_provider_id = openstack_identity_provider_v3.idp_1.id
+      mapping_id           = openstack_identity_mapping_v3.mapping_2.id
+      remote_id_attribute  = "HTTP_OIDC_ISS"
+    }
+  `, testAccIdentityV3ProtocolBase(name))
+}
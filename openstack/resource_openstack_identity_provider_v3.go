@@ -0,0 +1,197 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceIdentityProviderV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityProviderV3Create,
+		ReadContext:   resourceIdentityProviderV3Read,
+		UpdateContext: resourceIdentityProviderV3Update,
+		DeleteContext: resourceIdentityProviderV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"domain_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"remote_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"authorization_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func resourceIdentityProviderV3Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	description := d.Get("description").(string)
+	enabled := d.Get("enabled").(bool)
+	remoteIDs := expandToStringSlice(d.Get("remote_ids").(*schema.Set).List())
+	createOpts := identityProviderV3CreateOpts{
+		DomainID:    d.Get("domain_id").(string),
+		Description: &description,
+		Enabled:     &enabled,
+		RemoteIDs:   &remoteIDs,
+	}
+
+	if v, ok := getOkExists(d, "authorization_ttl"); ok {
+		ttl := v.(int)
+		createOpts.AuthorizationTTL = &ttl
+	}
+
+	log.Printf("[DEBUG] openstack_identity_provider_v3 create options: %#v", createOpts)
+
+	_, err = identityProviderV3Create(ctx, identityClient, name, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_identity_provider_v3: %s", err)
+	}
+
+	d.SetId(name)
+
+	return resourceIdentityProviderV3Read(ctx, d, meta)
+}
+
+func resourceIdentityProviderV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	idp, err := identityProviderV3Get(ctx, identityClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_identity_provider_v3"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_identity_provider_v3 %s: %#v", d.Id(), idp)
+
+	d.Set("name", idp.ID)
+	d.Set("domain_id", idp.DomainID)
+	d.Set("description", idp.Description)
+	d.Set("enabled", idp.Enabled)
+	d.Set("remote_ids", idp.RemoteIDs)
+	d.Set("region", GetRegion(d, config))
+
+	if idp.AuthorizationTTL != nil {
+		d.Set("authorization_ttl", *idp.AuthorizationTTL)
+	}
+
+	return nil
+}
+
+func resourceIdentityProviderV3Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	var hasChange bool
+
+	var updateOpts identityProviderV3CreateOpts
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("enabled") {
+		hasChange = true
+		enabled := d.Get("enabled").(bool)
+		updateOpts.Enabled = &enabled
+	}
+
+	if d.HasChange("remote_ids") {
+		hasChange = true
+		remoteIDs := expandToStringSlice(d.Get("remote_ids").(*schema.Set).List())
+		updateOpts.RemoteIDs = &remoteIDs
+	}
+
+	if d.HasChange("authorization_ttl") {
+		hasChange = true
+		ttl := d.Get("authorization_ttl").(int)
+		updateOpts.AuthorizationTTL = &ttl
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_identity_provider_v3 %s update options: %#v", d.Id(), updateOpts)
+
+		_, err := identityProviderV3Update(ctx, identityClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_identity_provider_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIdentityProviderV3Read(ctx, d, meta)
+}
+
+func resourceIdentityProviderV3Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	err = identityProviderV3Delete(ctx, identityClient, d.Id()).ExtractErr()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_identity_provider_v3"))
+	}
+
+	return nil
+}
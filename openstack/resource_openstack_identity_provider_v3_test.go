@@ -0,0 +1,138 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIdentityV3Provider_basic(t *testing.T) {
+	var idp identityProviderV3
+
+	idpName := "ACCPTTEST-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIdentityV3ProviderDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3ProviderBasic(idpName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProviderExists(t.Context(), "openstack_identity_provider_v3.idp_1", &idp),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_identity_provider_v3.idp_1", "name", &idp.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_provider_v3.idp_1", "description", "An identity provider"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_provider_v3.idp_1", "enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_provider_v3.idp_1", "remote_ids.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_identity_provider_v3.idp_1", "domain_id"),
+				),
+			},
+			{
+				Config: testAccIdentityV3ProviderUpdate(idpName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProviderExists(t.Context(), "openstack_identity_provider_v3.idp_1", &idp),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_provider_v3.idp_1", "description", "Some identity provider"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_provider_v3.idp_1", "enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_provider_v3.idp_1", "remote_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3ProviderDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		identityClient, err := config.IdentityV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_identity_provider_v3" {
+				continue
+			}
+
+			_, err := identityProviderV3Get(ctx, identityClient, rs.Primary.ID).Extract()
+			if err == nil {
+				return errors.New("Identity provider still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIdentityV3ProviderExists(ctx context.Context, n string, idp *identityProviderV3) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		identityClient, err := config.IdentityV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %w", err)
+		}
+
+		found, err := identityProviderV3Get(ctx, identityClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Identity provider not found")
+		}
+
+		*idp = *found
+
+		return nil
+	}
+}
+
+func testAccIdentityV3ProviderBasic(idpName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_provider_v3" "idp_1" {
+      name        = "%s"
+      description = "An identity provider"
+      remote_ids  = ["https://%s.example.com/realms/openstack"]
+    }
+  `, idpName, idpName)
+}
+
+func testAccIdentityV3ProviderUpdate(idpName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_provider_v3" "idp_1" {
+      name        = "%s"
+      description = "Some identity provider"
+      enabled     = false
+      remote_ids  = [
+        "https://%s.example.com/realms/openstack",
+        "https://%s.example.org/realms/openstack",
+      ]
+    }
+  `, idpName, idpName, idpName)
+}
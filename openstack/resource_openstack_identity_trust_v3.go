@@ -0,0 +1,196 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/trusts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceIdentityTrustV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityTrustV3Create,
+		ReadContext:   resourceIdentityTrustV3Read,
+		DeleteContext: resourceIdentityTrustV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"trustee_user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"trustor_user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"roles": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"impersonation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+
+			"allow_redelegation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+
+			"redelegation_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"expires_at": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"remaining_uses": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceIdentityTrustV3Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	tokenInfo, err := getTokenInfo(ctx, identityClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var expiresAt *time.Time
+	if v, err := time.Parse(time.RFC3339, d.Get("expires_at").(string)); err == nil {
+		expiresAt = &v
+	}
+
+	roles := expandIdentityTrustV3Roles(d.Get("roles").(*schema.Set).List())
+
+	// Roles can only be delegated on a project.
+	projectID := d.Get("project_id").(string)
+	if projectID == "" && len(roles) > 0 {
+		projectID = tokenInfo.projectID
+	}
+
+	createOpts := trusts.CreateOpts{
+		TrusteeUserID:     d.Get("trustee_user_id").(string),
+		TrustorUserID:     tokenInfo.userID,
+		ProjectID:         projectID,
+		Roles:             roles,
+		Impersonation:     d.Get("impersonation").(bool),
+		AllowRedelegation: d.Get("allow_redelegation").(bool),
+		RedelegationCount: d.Get("redelegation_count").(int),
+		RemainingUses:     d.Get("remaining_uses").(int),
+		ExpiresAt:         expiresAt,
+	}
+
+	log.Printf("[DEBUG] openstack_identity_trust_v3 create options: %#v", createOpts)
+
+	trust, err := trusts.Create(ctx, identityClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_identity_trust_v3: %s", err)
+	}
+
+	d.SetId(trust.ID)
+
+	return resourceIdentityTrustV3Read(ctx, d, meta)
+}
+
+func resourceIdentityTrustV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	trust, err := trusts.Get(ctx, identityClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_identity_trust_v3"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_identity_trust_v3 %s: %#v", d.Id(), trust)
+
+	d.Set("trustee_user_id", trust.TrusteeUserID)
+	d.Set("trustor_user_id", trust.TrustorUserID)
+	d.Set("project_id", trust.ProjectID)
+	d.Set("roles", flattenIdentityTrustV3Roles(trust.Roles))
+	d.Set("impersonation", trust.Impersonation)
+	d.Set("allow_redelegation", trust.AllowRedelegation)
+	d.Set("redelegation_count", trust.RedelegationCount)
+	d.Set("region", GetRegion(d, config))
+
+	// remaining_uses is decremented every time the trust is consumed,
+	// therefore the configured value is kept to avoid a replacement.
+
+	if trust.ExpiresAt.Equal((time.Time{})) {
+		d.Set("expires_at", "")
+	} else {
+		d.Set("expires_at", trust.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceIdentityTrustV3Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	err = trusts.Delete(ctx, identityClient, d.Id()).ExtractErr()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_identity_trust_v3"))
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/trusts"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIdentityV3Trust_basic(t *testing.T) {
+	var trust trusts.Trust
+
+	userName := "ACCPTTEST-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIdentityV3TrustDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3TrustBasic(userName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3TrustExists(t.Context(), "openstack_identity_trust_v3.trust_1", &trust),
+					resource.TestCheckResourceAttrPair(
+						"openstack_identity_trust_v3.trust_1", "trustee_user_id",
+						"openstack_identity_user_v3.user_1", "id"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_identity_trust_v3.trust_1", "trustor_user_id"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_identity_trust_v3.trust_1", "project_id"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_trust_v3.trust_1", "roles.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_trust_v3.trust_1", "impersonation", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_trust_v3.trust_1", "remaining_uses", "5"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_trust_v3.trust_1", "expires_at", "2100-01-01T00:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3TrustDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		identityClient, err := config.IdentityV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_identity_trust_v3" {
+				continue
+			}
+
+			_, err := trusts.Get(ctx, identityClient, rs.Primary.ID).Extract()
+			if err == nil {
+				return errors.New("Trust still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIdentityV3TrustExists(ctx context.Context, n string, trust *trusts.Trust) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		identityClient, err := config.IdentityV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %w", err)
+		}
+
+		found, err := trusts.Get(ctx, identityClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Trust not found")
+		}
+
+		*trust = *found
+
+		return nil
+	}
+}
+
+func testAccIdentityV3TrustBasic(userName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_user_v3" "user_1" {
+      name = "%s"
+    }
+
+    resource "openstack_identity_trust_v3" "trust_1" {
+      trustee_user_id = openstack_identity_user_v3.user_1.id
+      roles           = ["reader"]
+      remaining_uses  = 5
+      expires_at      = "2100-01-01T00:00:00Z"
+    }
+  `, userName)
+}
@@ -148,7 +148,7 @@ func resourceIdentityUserV3Create(ctx context.Context, d *schema.ResourceData, m
 
 	user, err := users.Create(ctx, identityClient, createOpts).Extract()
 	if err != nil {
-		return diag.Errorf("Error creating openstack_identity_user_v3: %s", err)
+		return diagOpenStackErrorf(err, "Error creating openstack_identity_user_v3")
 	}
 
 	d.SetId(user.ID)
@@ -271,7 +271,7 @@ func resourceIdentityUserV3Update(ctx context.Context, d *schema.ResourceData, m
 	if hasChange {
 		_, err := users.Update(ctx, identityClient, d.Id(), updateOpts).Extract()
 		if err != nil {
-			return diag.Errorf("Error updating openstack_identity_user_v3 %s: %s", d.Id(), err)
+			return diagOpenStackErrorf(err, "Error updating openstack_identity_user_v3 %s", d.Id())
 		}
 	}
 
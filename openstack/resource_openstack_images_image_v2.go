@@ -267,9 +267,11 @@ func resourceImagesImageV2Create(ctx context.Context, d *schema.ResourceData, me
 
 	newImg, err := images.Create(ctx, imageClient, createOpts).Extract()
 	if err != nil {
-		return diag.Errorf("Error creating Image: %s", err)
+		return diagOpenStackErrorf(err, "Error creating Image")
 	}
 
+	config.lookupCache.invalidate(lookupCacheImage, newImg.ID, newImg.Name)
+
 	d.SetId(newImg.ID)
 
 	var fileChecksum string
@@ -533,7 +535,11 @@ func resourceImagesImageV2Update(ctx context.Context, d *schema.ResourceData, me
 
 	_, err = images.Update(ctx, imageClient, d.Id(), updateOpts).Extract()
 	if err != nil {
-		return diag.Errorf("Error updating image: %s", err)
+		return diagOpenStackErrorf(err, "Error updating image")
+	}
+
+	if d.HasChange("name") {
+		config.lookupCache.invalidate(lookupCacheImage, d.Id(), d.Get("name").(string))
 	}
 
 	return resourceImagesImageV2Read(ctx, d, meta)
@@ -549,7 +555,10 @@ func resourceImagesImageV2Delete(ctx context.Context, d *schema.ResourceData, me
 
 	log.Printf("[DEBUG] Deleting Image %s", d.Id())
 
-	if err := images.Delete(ctx, imageClient, d.Id()).ExtractErr(); err != nil {
+	err = images.Delete(ctx, imageClient, d.Id()).ExtractErr()
+	config.lookupCache.invalidate(lookupCacheImage, d.Id(), "")
+
+	if err != nil {
 		return diag.FromErr(CheckDeleted(d, err, "Error deleting Image"))
 	}
 
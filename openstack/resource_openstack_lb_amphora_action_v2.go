@@ -0,0 +1,155 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/amphorae"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceLoadBalancerAmphoraActionV2 runs a failover or a configuration
+// update of an amphora. The action is run on create and whenever the triggers
+// change. There is nothing to read or delete afterwards, since a failover
+// replaces the amphora.
+func resourceLoadBalancerAmphoraActionV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLoadBalancerAmphoraActionV2Create,
+		ReadContext:   resourceLoadBalancerAmphoraActionV2Read,
+		DeleteContext: resourceLoadBalancerAmphoraActionV2Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"amphora_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					lbAmphoraV2ActionFailover, lbAmphoraV2ActionConfigure,
+				}, false),
+			},
+
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLoadBalancerAmphoraActionV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	amphoraID := d.Get("amphora_id").(string)
+	action := d.Get("action").(string)
+
+	amphora, err := amphorae.Get(ctx, lbClient, amphoraID).Extract()
+	if err != nil {
+		return diagOpenStackErrorf(err, "Error retrieving amphora %s", amphoraID)
+	}
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	// Wait for load-balancer to become active before continuing.
+	if amphora.LoadbalancerID != "" {
+		err = waitForLBV2LoadBalancer(ctx, lbClient, amphora.LoadbalancerID, "ACTIVE", getLbPendingStatuses(), timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// The load-balancer is still ACTIVE right after a failover was accepted,
+	// so its update time is needed to wait for the failover to start.
+	var updatedAt time.Time
+
+	if action == lbAmphoraV2ActionFailover && amphora.LoadbalancerID != "" {
+		lb, err := loadbalancers.Get(ctx, lbClient, amphora.LoadbalancerID).Extract()
+		if err != nil {
+			return diagOpenStackErrorf(err, "Error retrieving openstack_lb_loadbalancer_v2 %s", amphora.LoadbalancerID)
+		}
+
+		updatedAt = lb.UpdatedAt
+	}
+
+	log.Printf("[DEBUG] Running %s of amphora %s", action, amphoraID)
+
+	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		switch action {
+		case lbAmphoraV2ActionFailover:
+			err = amphorae.Failover(ctx, lbClient, amphoraID).ExtractErr()
+		case lbAmphoraV2ActionConfigure:
+			err = lbAmphoraV2Configure(ctx, lbClient, amphoraID).ExtractErr()
+		}
+
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return diagOpenStackErrorf(err, "Error running %s of amphora %s", action, amphoraID)
+	}
+
+	// The amphora is replaced while the load-balancer is PENDING_UPDATE.
+	if action == lbAmphoraV2ActionFailover && amphora.LoadbalancerID != "" {
+		err = waitForLBV2LoadBalancerUpdate(ctx, lbClient, amphora.LoadbalancerID, updatedAt, timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(amphoraID)
+	d.Set("loadbalancer_id", amphora.LoadbalancerID)
+
+	return resourceLoadBalancerAmphoraActionV2Read(ctx, d, meta)
+}
+
+func resourceLoadBalancerAmphoraActionV2Read(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceLoadBalancerAmphoraActionV2Delete(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing openstack_lb_amphora_action_v2 %s from the state", d.Id())
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLBV2AmphoraAction_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2LoadBalancerDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2AmphoraActionConfigure,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_lb_amphora_action_v2.action_1", "loadbalancer_id",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_amphora_action_v2.action_1", "action", "configure"),
+				),
+			},
+			{
+				Config: testAccLBV2AmphoraActionFailover,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_lb_amphora_action_v2.action_1", "loadbalancer_id",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_amphora_action_v2.action_1", "action", "failover"),
+				),
+			},
+		},
+	})
+}
+
+const testAccLBV2AmphoraActionLoadBalancer = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  loadbalancer_provider = "amphora"
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+
+data "openstack_lb_amphorae_v2" "amphorae_1" {
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+`
+
+const testAccLBV2AmphoraActionConfigure = testAccLBV2AmphoraActionLoadBalancer + `
+resource "openstack_lb_amphora_action_v2" "action_1" {
+  amphora_id = data.openstack_lb_amphorae_v2.amphorae_1.amphorae[0].id
+  action     = "configure"
+}
+`
+
+const testAccLBV2AmphoraActionFailover = testAccLBV2AmphoraActionLoadBalancer + `
+resource "openstack_lb_amphora_action_v2" "action_1" {
+  amphora_id = data.openstack_lb_amphorae_v2.amphorae_1.amphorae[0].id
+  action     = "failover"
+
+  # The failover replaces the amphora.
+  lifecycle {
+    ignore_changes = [amphora_id]
+  }
+
+  timeouts {
+    create = "15m"
+  }
+}
+`
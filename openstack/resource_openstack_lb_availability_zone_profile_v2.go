@@ -0,0 +1,174 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+func resourceLoadBalancerAvailabilityZoneProfileV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLoadBalancerAvailabilityZoneProfileV2Create,
+		ReadContext:   resourceLoadBalancerAvailabilityZoneProfileV2Read,
+		UpdateContext: resourceLoadBalancerAvailabilityZoneProfileV2Update,
+		DeleteContext: resourceLoadBalancerAvailabilityZoneProfileV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"provider_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// availability_zone_data depends on which provider is being
+			// used. Therefore we stay close to the API and make it type
+			// String. The user can use jsonencode to pass it properly
+			"availability_zone_data": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: diffSuppressJSONObject,
+				StateFunc: func(v any) string {
+					json, _ := structure.NormalizeJsonString(v)
+
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceLoadBalancerAvailabilityZoneProfileV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	createOpts := lbAvailabilityZoneProfileV2CreateOpts{
+		Name:                 d.Get("name").(string),
+		ProviderName:         d.Get("provider_name").(string),
+		AvailabilityZoneData: d.Get("availability_zone_data").(string),
+	}
+
+	profile, err := lbAvailabilityZoneProfileV2Create(ctx, lbClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_lb_availability_zone_profile_v2: %s", err)
+	}
+
+	d.SetId(profile.ID)
+
+	log.Printf("[DEBUG] Created openstack_lb_availability_zone_profile_v2 %#v", profile)
+
+	return resourceLoadBalancerAvailabilityZoneProfileV2Read(ctx, d, meta)
+}
+
+func resourceLoadBalancerAvailabilityZoneProfileV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	profile, err := lbAvailabilityZoneProfileV2Get(ctx, lbClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_lb_availability_zone_profile_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_availability_zone_profile_v2 %s: %#v", d.Id(), profile)
+
+	d.Set("name", profile.Name)
+	d.Set("provider_name", profile.ProviderName)
+	d.Set("availability_zone_data", profile.AvailabilityZoneData)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceLoadBalancerAvailabilityZoneProfileV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	var (
+		hasChange  bool
+		updateOpts lbAvailabilityZoneProfileV2UpdateOpts
+	)
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("provider_name") {
+		hasChange = true
+		providerName := d.Get("provider_name").(string)
+		updateOpts.ProviderName = &providerName
+	}
+
+	if d.HasChange("availability_zone_data") {
+		hasChange = true
+		availabilityZoneData := d.Get("availability_zone_data").(string)
+		updateOpts.AvailabilityZoneData = &availabilityZoneData
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_lb_availability_zone_profile_v2 %s update options: %#v", d.Id(), updateOpts)
+
+		_, err := lbAvailabilityZoneProfileV2Update(ctx, lbClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_lb_availability_zone_profile_v2: %s", err)
+		}
+	}
+
+	return resourceLoadBalancerAvailabilityZoneProfileV2Read(ctx, d, meta)
+}
+
+func resourceLoadBalancerAvailabilityZoneProfileV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting openstack_lb_availability_zone_profile_v2: %s", d.Id())
+
+	if err := lbAvailabilityZoneProfileV2Delete(ctx, lbClient, d.Id()).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_lb_availability_zone_profile_v2"))
+	}
+
+	d.SetId("")
+
+	return nil
+}
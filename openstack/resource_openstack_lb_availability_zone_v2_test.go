@@ -0,0 +1,142 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccLBV2AvailabilityZone_basic(t *testing.T) {
+	var availabilityZone lbAvailabilityZoneV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2AvailabilityZoneDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLbV2AvailabilityZone,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2AvailabilityZoneExists(t.Context(), "openstack_lb_availability_zone_v2.az_1", &availabilityZone),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availability_zone_v2.az_1", "name", "test"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availability_zone_v2.az_1", "description", "test"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availability_zone_v2.az_1", "enabled", "true"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_lb_availability_zone_v2.az_1", "availability_zone_profile_id",
+						"openstack_lb_availability_zone_profile_v2.azp_1", "id"),
+				),
+			},
+			{
+				Config: testAccCheckLbV2AvailabilityZoneUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2AvailabilityZoneExists(t.Context(), "openstack_lb_availability_zone_v2.az_1", &availabilityZone),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availability_zone_v2.az_1", "description", "test-2"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availability_zone_v2.az_1", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2AvailabilityZoneDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		lbClient, err := config.LoadBalancerV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack load balancing client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_lb_availability_zone_v2" {
+				continue
+			}
+
+			_, err := lbAvailabilityZoneV2Get(ctx, lbClient, rs.Primary.ID).Extract()
+			if err == nil {
+				return fmt.Errorf("Availability zone still exists: %s", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLBV2AvailabilityZoneExists(ctx context.Context, n string, availabilityZone *lbAvailabilityZoneV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		lbClient, err := config.LoadBalancerV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack load balancing client: %w", err)
+		}
+
+		found, err := lbAvailabilityZoneV2Get(ctx, lbClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.Name != rs.Primary.ID {
+			return errors.New("Availability zone not found")
+		}
+
+		*availabilityZone = *found
+
+		return nil
+	}
+}
+
+const testAccCheckLbV2AvailabilityZone = `
+resource "openstack_lb_availability_zone_profile_v2" "azp_1" {
+  name                   = "test"
+  provider_name          = "amphora"
+  availability_zone_data = jsonencode({
+    "compute_zone": "nova",
+  })
+}
+
+resource "openstack_lb_availability_zone_v2" "az_1" {
+  name                         = "test"
+  description                  = "test"
+  availability_zone_profile_id = openstack_lb_availability_zone_profile_v2.azp_1.id
+}
+`
+
+const testAccCheckLbV2AvailabilityZoneUpdate = `
+resource "openstack_lb_availability_zone_profile_v2" "azp_1" {
+  name                   = "test"
+  provider_name          = "amphora"
+  availability_zone_data = jsonencode({
+    "compute_zone": "nova",
+  })
+}
+
+resource "openstack_lb_availability_zone_v2" "az_1" {
+  name                         = "test"
+  description                  = "test-2"
+  enabled                      = false
+  availability_zone_profile_id = openstack_lb_availability_zone_profile_v2.azp_1.id
+}
+`
@@ -302,7 +302,7 @@ func resourceListenerV2Create(ctx context.Context, d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return diag.Errorf("Error creating openstack_lb_listener_v2: %s", err)
+		return diagOpenStackAttributeErrorf(err, lbV2ListenerErrorAttributes(), "Error creating openstack_lb_listener_v2")
 	}
 
 	// Wait for the listener to become ACTIVE.
@@ -562,7 +562,7 @@ func resourceListenerV2Update(ctx context.Context, d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return diag.Errorf("Error updating openstack_lb_listener_v2 %s: %s", d.Id(), err)
+		return diagOpenStackAttributeErrorf(err, lbV2ListenerErrorAttributes(), "Error updating openstack_lb_listener_v2 %s", d.Id())
 	}
 
 	// Wait for the listener to become ACTIVE.
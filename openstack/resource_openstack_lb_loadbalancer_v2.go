@@ -137,6 +137,13 @@ func resourceLoadBalancerV2() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+
+			"failover_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"listener": lbLoadBalancerV2ListenerSchema(),
 		},
 	}
 }
@@ -186,11 +193,17 @@ func resourceLoadBalancerV2Create(ctx context.Context, d *schema.ResourceData, m
 		createOpts.Tags = expandToStringSlice(tags)
 	}
 
+	// The listeners, pools, members and health monitors are created together
+	// with the load-balancer in a single call.
+	if v, ok := d.GetOk("listener"); ok {
+		createOpts.Listeners = expandLBLoadBalancerV2Listeners(v.([]any))
+	}
+
 	log.Printf("[DEBUG] openstack_lb_loadbalancer_v2 create options: %#v", createOpts)
 
 	lb, err := loadbalancers.Create(ctx, lbClient, createOpts).Extract()
 	if err != nil {
-		return diag.Errorf("Error creating openstack_lb_loadbalancer_v2: %s", err)
+		return diagOpenStackErrorf(err, "Error creating openstack_lb_loadbalancer_v2")
 	}
 
 	lbID = lb.ID
@@ -253,6 +266,19 @@ func resourceLoadBalancerV2Read(ctx context.Context, d *schema.ResourceData, met
 	d.Set("tags", lb.Tags)
 	d.Set("vip_qos_policy_id", lb.VipQosPolicyID)
 
+	// Only read the listeners, when they are managed inline. Otherwise they
+	// are managed by openstack_lb_listener_v2 resources.
+	if v, ok := d.GetOk("listener"); ok {
+		listeners, err := flattenLBLoadBalancerV2Listeners(ctx, lbClient, d.Id(), v.([]any))
+		if err != nil {
+			return diag.Errorf("Error retrieving listeners of openstack_lb_loadbalancer_v2 %s: %s", d.Id(), err)
+		}
+
+		if err := d.Set("listener", listeners); err != nil {
+			return diag.Errorf("Unable to set openstack_lb_loadbalancer_v2 %s listeners: %s", d.Id(), err)
+		}
+	}
+
 	vipPortID = lb.VipPortID
 
 	// Get any security groups on the VIP Port.
@@ -338,7 +364,7 @@ func resourceLoadBalancerV2Update(ctx context.Context, d *schema.ResourceData, m
 			return nil
 		})
 		if err != nil {
-			return diag.Errorf("Error updating openstack_lb_loadbalancer_v2 %s: %s", d.Id(), err)
+			return diagOpenStackErrorf(err, "Error updating openstack_lb_loadbalancer_v2 %s", d.Id())
 		}
 
 		// Wait for load-balancer to become active before continuing.
@@ -348,6 +374,51 @@ func resourceLoadBalancerV2Update(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if d.HasChange("failover_trigger") {
+		timeout := d.Timeout(schema.TimeoutUpdate)
+
+		err = waitForLBV2LoadBalancer(ctx, lbClient, d.Id(), "ACTIVE", getLbPendingStatuses(), timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		lb, err := loadbalancers.Get(ctx, lbClient, d.Id()).Extract()
+		if err != nil {
+			return diagOpenStackErrorf(err, "Error retrieving openstack_lb_loadbalancer_v2 %s", d.Id())
+		}
+
+		log.Printf("[DEBUG] Failing over openstack_lb_loadbalancer_v2 %s", d.Id())
+
+		err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			err = loadbalancers.Failover(ctx, lbClient, d.Id()).ExtractErr()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return diagOpenStackErrorf(err, "Error failing over openstack_lb_loadbalancer_v2 %s", d.Id())
+		}
+
+		// The amphorae are replaced while the load-balancer is PENDING_UPDATE.
+		// It is still ACTIVE right after the failover was accepted, so wait
+		// for it to be updated first.
+		err = waitForLBV2LoadBalancerUpdate(ctx, lbClient, d.Id(), lb.UpdatedAt, timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("listener") {
+		o, n := d.GetChange("listener")
+
+		err = updateLBLoadBalancerV2Listeners(ctx, lbClient, d.Id(), o.([]any), n.([]any), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diagOpenStackErrorf(err, "Error updating listeners of openstack_lb_loadbalancer_v2 %s", d.Id())
+		}
+	}
+
 	// Security Groups get updated separately.
 	if d.HasChange("security_group_ids") {
 		networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
@@ -375,8 +446,14 @@ func resourceLoadBalancerV2Delete(ctx context.Context, d *schema.ResourceData, m
 	log.Printf("[DEBUG] Deleting openstack_lb_loadbalancer_v2 %s", d.Id())
 	timeout := d.Timeout(schema.TimeoutDelete)
 
+	// The inline listeners, pools, members and health monitors are deleted
+	// together with the load-balancer.
+	deleteOpts := loadbalancers.DeleteOpts{
+		Cascade: len(d.Get("listener").([]any)) > 0,
+	}
+
 	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
-		err = loadbalancers.Delete(ctx, lbClient, d.Id(), loadbalancers.DeleteOpts{}).ExtractErr()
+		err = loadbalancers.Delete(ctx, lbClient, d.Id(), deleteOpts).ExtractErr()
 		if err != nil {
 			return checkForRetryableError(err)
 		}
@@ -165,6 +165,84 @@ func TestAccLBV2LoadBalancer_vip_port_id(t *testing.T) {
 	})
 }
 
+func TestAccLBV2LoadBalancer_failover(t *testing.T) {
+	var lb loadbalancers.LoadBalancer
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2LoadBalancerDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2LoadBalancerConfigFailover("image_1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2LoadBalancerExists(t.Context(), "openstack_lb_loadbalancer_v2.loadbalancer_1", &lb),
+				),
+			},
+			{
+				Config: testAccLbV2LoadBalancerConfigFailover("image_2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2LoadBalancerExists(t.Context(), "openstack_lb_loadbalancer_v2.loadbalancer_1", &lb),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "failover_trigger", "image_2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLBV2LoadBalancer_inlineListeners(t *testing.T) {
+	var lb loadbalancers.LoadBalancer
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2LoadBalancerDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2LoadBalancerConfigInlineListeners,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2LoadBalancerExists(t.Context(), "openstack_lb_loadbalancer_v2.loadbalancer_1", &lb),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "listener.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "listener.0.default_pool.0.member.#", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "listener.0.default_pool.0.healthmonitor.0.delay", "20"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "listener.0.default_pool.0.id"),
+				),
+			},
+			{
+				Config: testAccLbV2LoadBalancerConfigInlineListenersUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2LoadBalancerExists(t.Context(), "openstack_lb_loadbalancer_v2.loadbalancer_1", &lb),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "listener.#", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "listener.0.default_pool.0.lb_method", "LEAST_CONNECTIONS"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "listener.0.default_pool.0.member.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "listener.0.default_pool.0.member.0.weight", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "listener.0.default_pool.0.healthmonitor.0.delay", "30"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "listener.1.protocol", "TCP"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLBV2LoadBalancerDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := testAccProvider.Meta().(*Config)
@@ -550,3 +628,150 @@ resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
   }
 }
 `
+
+func testAccLbV2LoadBalancerConfigFailover(trigger string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  loadbalancer_provider = "amphora"
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+  failover_trigger = "%s"
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+`, trigger)
+}
+
+const testAccLbV2LoadBalancerConfigInlineListeners = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+
+  listener {
+    name = "listener_1"
+    protocol = "HTTP"
+    protocol_port = 8080
+
+    default_pool {
+      name = "pool_1"
+      protocol = "HTTP"
+      lb_method = "ROUND_ROBIN"
+
+      healthmonitor {
+        type = "HTTP"
+        delay = 20
+        timeout = 10
+        max_retries = 5
+      }
+
+      member {
+        address = "192.168.199.110"
+        protocol_port = 8080
+        subnet_id = openstack_networking_subnet_v2.subnet_1.id
+      }
+
+      member {
+        address = "192.168.199.111"
+        protocol_port = 8080
+        subnet_id = openstack_networking_subnet_v2.subnet_1.id
+      }
+    }
+  }
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+`
+
+const testAccLbV2LoadBalancerConfigInlineListenersUpdate = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+
+  listener {
+    name = "listener_1"
+    protocol = "HTTP"
+    protocol_port = 8080
+
+    default_pool {
+      name = "pool_1"
+      protocol = "HTTP"
+      lb_method = "LEAST_CONNECTIONS"
+
+      healthmonitor {
+        type = "HTTP"
+        delay = 30
+        timeout = 10
+        max_retries = 5
+      }
+
+      member {
+        address = "192.168.199.110"
+        protocol_port = 8080
+        subnet_id = openstack_networking_subnet_v2.subnet_1.id
+        weight = 10
+      }
+    }
+  }
+
+  listener {
+    name = "listener_2"
+    protocol = "TCP"
+    protocol_port = 22
+
+    default_pool {
+      name = "pool_2"
+      protocol = "TCP"
+      lb_method = "SOURCE_IP"
+    }
+  }
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+`
@@ -199,7 +199,7 @@ func resourceMemberV2Create(ctx context.Context, d *schema.ResourceData, meta an
 		return nil
 	})
 	if err != nil {
-		return diag.Errorf("Error creating member: %s", err)
+		return diagOpenStackErrorf(err, "Error creating member")
 	}
 
 	// Wait for member to become active before continuing
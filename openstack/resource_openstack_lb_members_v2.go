@@ -117,7 +117,7 @@ func resourceMembersV2Create(ctx context.Context, d *schema.ResourceData, meta a
 		return diag.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	createOpts := expandLBMembersV2(d.Get("member").(*schema.Set))
+	createOpts := expandLBMembersV2(d.Get("member").(*schema.Set).List())
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 
 	// Get a clean copy of the parent pool.
@@ -196,7 +196,7 @@ func resourceMembersV2Update(ctx context.Context, d *schema.ResourceData, meta a
 	}
 
 	if d.HasChange("member") {
-		updateOpts := expandLBMembersV2(d.Get("member").(*schema.Set))
+		updateOpts := expandLBMembersV2(d.Get("member").(*schema.Set).List())
 
 		// Get a clean copy of the parent pool.
 		parentPool, err := pools.Get(ctx, lbClient, d.Id()).Extract()
@@ -257,7 +257,7 @@ func resourcePoolV2Create(ctx context.Context, d *schema.ResourceData, meta any)
 		return nil
 	})
 	if err != nil {
-		return diag.Errorf("Error creating pool: %s", err)
+		return diagOpenStackErrorf(err, "Error creating pool")
 	}
 
 	// Pool was successfully created
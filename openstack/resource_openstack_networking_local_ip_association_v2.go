@@ -0,0 +1,138 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkingLocalIPAssociationV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingLocalIPAssociationV2Create,
+		ReadContext:   resourceNetworkingLocalIPAssociationV2Read,
+		DeleteContext: resourceNetworkingLocalIPAssociationV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"local_ip_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"fixed_port_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"fixed_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"local_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingLocalIPAssociationV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	localIPID := d.Get("local_ip_id").(string)
+	createOpts := networkingLocalIPAssociationV2CreateOpts{
+		FixedPortID: d.Get("fixed_port_id").(string),
+		FixedIP:     d.Get("fixed_ip").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_networking_local_ip_association_v2 create options: %#v", createOpts)
+
+	association, err := networkingLocalIPAssociationV2Create(ctx, networkingClient, localIPID, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error associating openstack_networking_local_ip_association_v2 local IP %s with port %s: %s", localIPID, createOpts.FixedPortID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", localIPID, association.FixedPortID))
+
+	return resourceNetworkingLocalIPAssociationV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingLocalIPAssociationV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	localIPID, fixedPortID, err := parsePairedIDs(d.Id(), "openstack_networking_local_ip_association_v2")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	association, err := networkingLocalIPAssociationV2Get(ctx, networkingClient, localIPID, fixedPortID)
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error getting openstack_networking_local_ip_association_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_local_ip_association_v2 %s: %#v", d.Id(), association)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("local_ip_id", localIPID)
+	d.Set("fixed_port_id", association.FixedPortID)
+	d.Set("fixed_ip", association.FixedIP)
+	d.Set("local_ip_address", association.LocalIPAddress)
+	d.Set("host", association.Host)
+
+	return nil
+}
+
+func resourceNetworkingLocalIPAssociationV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	localIPID, fixedPortID, err := parsePairedIDs(d.Id(), "openstack_networking_local_ip_association_v2")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = networkingLocalIPAssociationV2Delete(ctx, networkingClient, localIPID, fixedPortID).ExtractErr()
+	if err != nil && CheckDeleted(d, err, "") != nil {
+		return diag.Errorf("Error disassociating openstack_networking_local_ip_association_v2 local IP %s from port %s: %s", localIPID, fixedPortID, err)
+	}
+
+	return nil
+}
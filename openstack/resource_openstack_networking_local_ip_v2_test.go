@@ -0,0 +1,203 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNetworkingV2LocalIP_basic(t *testing.T) {
+	var localIP networkingLocalIPV2
+
+	name := acctest.RandomWithPrefix("tf-acc-local-ip")
+	newName := acctest.RandomWithPrefix("tf-acc-local-ip")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLocalIP(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2LocalIPDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2LocalIPBasic(name, "local IP"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2LocalIPExists(t.Context(), "openstack_networking_local_ip_v2.local_ip_1", &localIP),
+					resource.TestCheckResourceAttr("openstack_networking_local_ip_v2.local_ip_1", "name", name),
+					resource.TestCheckResourceAttr("openstack_networking_local_ip_v2.local_ip_1", "description", "local IP"),
+					resource.TestCheckResourceAttr("openstack_networking_local_ip_v2.local_ip_1", "ip_mode", "translate"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_local_ip_v2.local_ip_1", "network_id",
+						"openstack_networking_network_v2.network_1", "id"),
+					resource.TestCheckResourceAttrSet("openstack_networking_local_ip_v2.local_ip_1", "local_port_id"),
+					resource.TestCheckResourceAttrSet("openstack_networking_local_ip_v2.local_ip_1", "local_ip_address"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2LocalIPBasic(newName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("openstack_networking_local_ip_v2.local_ip_1", "name", newName),
+					resource.TestCheckResourceAttr("openstack_networking_local_ip_v2.local_ip_1", "description", ""),
+					resource.TestCheckResourceAttrPtr("openstack_networking_local_ip_v2.local_ip_1", "id", &localIP.ID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2LocalIPAssociation_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLocalIP(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2LocalIPDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2LocalIPAssociationBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_local_ip_association_v2.association_1", "local_ip_id",
+						"openstack_networking_local_ip_v2.local_ip_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_local_ip_association_v2.association_1", "fixed_port_id",
+						"openstack_networking_port_v2.port_1", "id"),
+					resource.TestCheckResourceAttr("openstack_networking_local_ip_association_v2.association_1", "fixed_ip", "192.168.199.10"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_local_ip_association_v2.association_1", "local_ip_address",
+						"openstack_networking_local_ip_v2.local_ip_1", "local_ip_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2LocalIPExists(ctx context.Context, n string, localIP *networkingLocalIPV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		found, err := networkingLocalIPV2Get(ctx, networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Local IP not found")
+		}
+
+		*localIP = *found
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2LocalIPDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_networking_local_ip_v2" {
+				continue
+			}
+
+			_, err := networkingLocalIPV2Get(ctx, networkingClient, rs.Primary.ID).Extract()
+			if err == nil {
+				return errors.New("Local IP still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccNetworkingV2LocalIPBasic(name, description string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name           = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name       = "subnet_1"
+  cidr       = "192.168.199.0/24"
+  ip_version = 4
+  network_id = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_networking_local_ip_v2" "local_ip_1" {
+  name        = "%s"
+  description = "%s"
+  network_id  = openstack_networking_network_v2.network_1.id
+
+  depends_on = [openstack_networking_subnet_v2.subnet_1]
+}
+`, name, description)
+}
+
+func testAccNetworkingV2LocalIPAssociationBasic() string {
+	return `
+resource "openstack_networking_network_v2" "network_1" {
+  name           = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name       = "subnet_1"
+  cidr       = "192.168.199.0/24"
+  ip_version = 4
+  network_id = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name           = "port_1"
+  admin_state_up = "true"
+  network_id     = openstack_networking_network_v2.network_1.id
+
+  fixed_ip {
+    subnet_id  = openstack_networking_subnet_v2.subnet_1.id
+    ip_address = "192.168.199.10"
+  }
+}
+
+resource "openstack_networking_local_ip_v2" "local_ip_1" {
+  name       = "local_ip_1"
+  network_id = openstack_networking_network_v2.network_1.id
+
+  depends_on = [openstack_networking_subnet_v2.subnet_1]
+}
+
+resource "openstack_networking_local_ip_association_v2" "association_1" {
+  local_ip_id   = openstack_networking_local_ip_v2.local_ip_1.id
+  fixed_port_id = openstack_networking_port_v2.port_1.id
+  fixed_ip      = "192.168.199.10"
+}
+`
+}
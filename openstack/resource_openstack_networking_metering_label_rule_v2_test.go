@@ -0,0 +1,124 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNetworkingV2MeteringLabelRule_basic(t *testing.T) {
+	var rule networkingMeteringLabelRuleV2
+
+	name := acctest.RandomWithPrefix("tf-acc-metering-label")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2MeteringLabelRuleDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2MeteringLabelRuleBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2MeteringLabelRuleExists(t.Context(), "openstack_networking_metering_label_rule_v2.rule_1", &rule),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_metering_label_rule_v2.rule_1", "metering_label_id",
+						"openstack_networking_metering_label_v2.label_1", "id"),
+					resource.TestCheckResourceAttr("openstack_networking_metering_label_rule_v2.rule_1", "direction", "egress"),
+					resource.TestCheckResourceAttr("openstack_networking_metering_label_rule_v2.rule_1", "remote_ip_prefix", "10.0.0.0/24"),
+					resource.TestCheckResourceAttr("openstack_networking_metering_label_rule_v2.rule_1", "excluded", "false"),
+					resource.TestCheckResourceAttr("openstack_networking_metering_label_rule_v2.rule_2", "direction", "ingress"),
+					resource.TestCheckResourceAttr("openstack_networking_metering_label_rule_v2.rule_2", "source_ip_prefix", "10.0.1.0/24"),
+					resource.TestCheckResourceAttr("openstack_networking_metering_label_rule_v2.rule_2", "destination_ip_prefix", "10.0.2.0/24"),
+					resource.TestCheckResourceAttr("openstack_networking_metering_label_rule_v2.rule_2", "excluded", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2MeteringLabelRuleExists(ctx context.Context, n string, rule *networkingMeteringLabelRuleV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		found, err := networkingMeteringLabelRuleV2Get(ctx, networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Metering label rule not found")
+		}
+
+		*rule = *found
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2MeteringLabelRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_networking_metering_label_rule_v2" {
+				continue
+			}
+
+			_, err := networkingMeteringLabelRuleV2Get(ctx, networkingClient, rs.Primary.ID).Extract()
+			if err == nil {
+				return errors.New("Metering label rule still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccNetworkingV2MeteringLabelRuleBasic(name string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_metering_label_v2" "label_1" {
+  name = "%s"
+}
+
+resource "openstack_networking_metering_label_rule_v2" "rule_1" {
+  metering_label_id = openstack_networking_metering_label_v2.label_1.id
+  direction         = "egress"
+  remote_ip_prefix  = "10.0.0.0/24"
+}
+
+resource "openstack_networking_metering_label_rule_v2" "rule_2" {
+  metering_label_id     = openstack_networking_metering_label_v2.label_1.id
+  direction             = "ingress"
+  source_ip_prefix      = "10.0.1.0/24"
+  destination_ip_prefix = "10.0.2.0/24"
+  excluded              = true
+}
+`, name)
+}
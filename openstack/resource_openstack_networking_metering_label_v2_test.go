@@ -0,0 +1,105 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNetworkingV2MeteringLabel_basic(t *testing.T) {
+	var label networkingMeteringLabelV2
+
+	name := acctest.RandomWithPrefix("tf-acc-metering-label")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2MeteringLabelDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2MeteringLabelBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2MeteringLabelExists(t.Context(), "openstack_networking_metering_label_v2.label_1", &label),
+					resource.TestCheckResourceAttr("openstack_networking_metering_label_v2.label_1", "name", name),
+					resource.TestCheckResourceAttr("openstack_networking_metering_label_v2.label_1", "description", "metering label"),
+					resource.TestCheckResourceAttr("openstack_networking_metering_label_v2.label_1", "shared", "false"),
+					resource.TestCheckResourceAttrSet("openstack_networking_metering_label_v2.label_1", "project_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2MeteringLabelExists(ctx context.Context, n string, label *networkingMeteringLabelV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		found, err := networkingMeteringLabelV2Get(ctx, networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Metering label not found")
+		}
+
+		*label = *found
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2MeteringLabelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_networking_metering_label_v2" {
+				continue
+			}
+
+			_, err := networkingMeteringLabelV2Get(ctx, networkingClient, rs.Primary.ID).Extract()
+			if err == nil {
+				return errors.New("Metering label still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccNetworkingV2MeteringLabelBasic(name string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_metering_label_v2" "label_1" {
+  name        = "%s"
+  description = "metering label"
+}
+`, name)
+}
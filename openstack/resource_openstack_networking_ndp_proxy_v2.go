@@ -0,0 +1,172 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkingNDPProxyV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingNDPProxyV2Create,
+		ReadContext:   resourceNetworkingNDPProxyV2Read,
+		UpdateContext: resourceNetworkingNDPProxyV2Update,
+		DeleteContext: resourceNetworkingNDPProxyV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"router_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"port_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPv6Address,
+			},
+		},
+	}
+}
+
+func resourceNetworkingNDPProxyV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := networkingNDPProxyV2CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		ProjectID:   d.Get("project_id").(string),
+		RouterID:    d.Get("router_id").(string),
+		PortID:      d.Get("port_id").(string),
+		IPAddress:   d.Get("ip_address").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_networking_ndp_proxy_v2 create options: %#v", createOpts)
+
+	ndpProxy, err := networkingNDPProxyV2Create(ctx, networkingClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_networking_ndp_proxy_v2: %s", err)
+	}
+
+	d.SetId(ndpProxy.ID)
+
+	log.Printf("[DEBUG] Created openstack_networking_ndp_proxy_v2 %s: %#v", ndpProxy.ID, ndpProxy)
+
+	return resourceNetworkingNDPProxyV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingNDPProxyV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	ndpProxy, err := networkingNDPProxyV2Get(ctx, networkingClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error getting openstack_networking_ndp_proxy_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_ndp_proxy_v2 %s: %#v", d.Id(), ndpProxy)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("name", ndpProxy.Name)
+	d.Set("description", ndpProxy.Description)
+	d.Set("project_id", ndpProxy.ProjectID)
+	d.Set("router_id", ndpProxy.RouterID)
+	d.Set("port_id", ndpProxy.PortID)
+	d.Set("ip_address", ndpProxy.IPAddress)
+
+	return nil
+}
+
+func resourceNetworkingNDPProxyV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var updateOpts networkingNDPProxyV2UpdateOpts
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if updateOpts != (networkingNDPProxyV2UpdateOpts{}) {
+		log.Printf("[DEBUG] openstack_networking_ndp_proxy_v2 %s update options: %#v", d.Id(), updateOpts)
+
+		_, err = networkingNDPProxyV2Update(ctx, networkingClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_networking_ndp_proxy_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkingNDPProxyV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingNDPProxyV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if err := networkingNDPProxyV2Delete(ctx, networkingClient, d.Id()).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_networking_ndp_proxy_v2"))
+	}
+
+	return nil
+}
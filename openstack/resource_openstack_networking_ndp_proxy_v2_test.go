@@ -0,0 +1,163 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNetworkingV2NDPProxy_basic(t *testing.T) {
+	var ndpProxy networkingNDPProxyV2
+
+	name := acctest.RandomWithPrefix("tf-acc-ndp-proxy")
+	newName := acctest.RandomWithPrefix("tf-acc-ndp-proxy")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckNDPProxy(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2NDPProxyDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2NDPProxyBasic(name, "NDP proxy"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2NDPProxyExists(t.Context(), "openstack_networking_ndp_proxy_v2.ndp_proxy_1", &ndpProxy),
+					resource.TestCheckResourceAttr("openstack_networking_ndp_proxy_v2.ndp_proxy_1", "name", name),
+					resource.TestCheckResourceAttr("openstack_networking_ndp_proxy_v2.ndp_proxy_1", "description", "NDP proxy"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_ndp_proxy_v2.ndp_proxy_1", "router_id",
+						"openstack_networking_router_v2.router_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_ndp_proxy_v2.ndp_proxy_1", "port_id",
+						"openstack_networking_port_v2.port_1", "id"),
+					resource.TestCheckResourceAttrSet("openstack_networking_ndp_proxy_v2.ndp_proxy_1", "ip_address"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2NDPProxyBasic(newName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("openstack_networking_ndp_proxy_v2.ndp_proxy_1", "name", newName),
+					resource.TestCheckResourceAttr("openstack_networking_ndp_proxy_v2.ndp_proxy_1", "description", ""),
+					resource.TestCheckResourceAttrPtr("openstack_networking_ndp_proxy_v2.ndp_proxy_1", "id", &ndpProxy.ID),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2NDPProxyExists(ctx context.Context, n string, ndpProxy *networkingNDPProxyV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		found, err := networkingNDPProxyV2Get(ctx, networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("NDP proxy not found")
+		}
+
+		*ndpProxy = *found
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2NDPProxyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_networking_ndp_proxy_v2" {
+				continue
+			}
+
+			_, err := networkingNDPProxyV2Get(ctx, networkingClient, rs.Primary.ID).Extract()
+			if err == nil {
+				return errors.New("NDP proxy still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccNetworkingV2NDPProxyBasic(name, description string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name           = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name              = "subnet_1"
+  cidr              = "fd00:dead:beef::/64"
+  ip_version        = 6
+  ipv6_address_mode = "slaac"
+  ipv6_ra_mode      = "slaac"
+  network_id        = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_networking_router_v2" "router_1" {
+  name                = "router_1"
+  admin_state_up      = "true"
+  external_network_id = "%s"
+
+  value_specs = {
+    enable_ndp_proxy = "true"
+  }
+}
+
+resource "openstack_networking_router_interface_v2" "router_interface_1" {
+  router_id = openstack_networking_router_v2.router_1.id
+  subnet_id = openstack_networking_subnet_v2.subnet_1.id
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name           = "port_1"
+  admin_state_up = "true"
+  network_id     = openstack_networking_network_v2.network_1.id
+
+  fixed_ip {
+    subnet_id = openstack_networking_subnet_v2.subnet_1.id
+  }
+}
+
+resource "openstack_networking_ndp_proxy_v2" "ndp_proxy_1" {
+  name        = "%s"
+  description = "%s"
+  router_id   = openstack_networking_router_v2.router_1.id
+  port_id     = openstack_networking_port_v2.port_1.id
+
+  depends_on = [openstack_networking_router_interface_v2.router_interface_1]
+}
+`, osExtGwID, name, description)
+}
@@ -268,7 +268,7 @@ func resourceNetworkingNetworkV2Create(ctx context.Context, d *schema.ResourceDa
 
 	n, err := networks.Create(ctx, networkingClient, finalCreateOpts).Extract()
 	if err != nil {
-		return diag.Errorf("Error creating openstack_networking_network_v2: %s", err)
+		return diagOpenStackErrorf(err, "Error creating openstack_networking_network_v2")
 	}
 
 	log.Printf("[DEBUG] Waiting for openstack_networking_network_v2 %s to become available.", n.ID)
@@ -287,6 +287,8 @@ func resourceNetworkingNetworkV2Create(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("Error waiting for openstack_networking_network_v2 %s to become available: %s", n.ID, err)
 	}
 
+	config.lookupCache.invalidate(lookupCacheNetwork, n.ID, n.Name)
+
 	d.SetId(n.ID)
 
 	tags := networkingV2AttributesTags(d)
@@ -451,7 +453,11 @@ func resourceNetworkingNetworkV2Update(ctx context.Context, d *schema.ResourceDa
 
 	_, err = networks.Update(ctx, networkingClient, d.Id(), finalUpdateOpts).Extract()
 	if err != nil {
-		return diag.Errorf("Error updating openstack_networking_network_v2 %s: %s", d.Id(), err)
+		return diagOpenStackErrorf(err, "Error updating openstack_networking_network_v2 %s", d.Id())
+	}
+
+	if d.HasChange("name") {
+		config.lookupCache.invalidate(lookupCacheNetwork, d.Id(), d.Get("name").(string))
 	}
 
 	return resourceNetworkingNetworkV2Read(ctx, d, meta)
@@ -465,7 +471,10 @@ func resourceNetworkingNetworkV2Delete(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	if err := networks.Delete(ctx, networkingClient, d.Id()).ExtractErr(); err != nil {
+	err = networks.Delete(ctx, networkingClient, d.Id()).ExtractErr()
+	config.lookupCache.invalidate(lookupCacheNetwork, d.Id(), "")
+
+	if err != nil {
 		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_networking_network_v2"))
 	}
 
@@ -395,7 +395,7 @@ func resourceNetworkingPortV2Create(ctx context.Context, d *schema.ResourceData,
 
 	err = ports.Create(ctx, networkingClient, finalCreateOpts).ExtractInto(&port)
 	if err != nil {
-		return diag.Errorf("Error creating openstack_networking_port_v2: %s", err)
+		return diagOpenStackAttributeErrorf(err, networkingPortV2ErrorAttributes(), "Error creating openstack_networking_port_v2")
 	}
 
 	log.Printf("[DEBUG] Waiting for openstack_networking_port_v2 %s to become available.", port.ID)
@@ -665,7 +665,7 @@ func resourceNetworkingPortV2Update(ctx context.Context, d *schema.ResourceData,
 
 		_, err = ports.Update(ctx, networkingClient, d.Id(), finalUpdateOpts).Extract()
 		if err != nil {
-			return diag.Errorf("Error updating OpenStack Neutron Port: %s", err)
+			return diagOpenStackAttributeErrorf(err, networkingPortV2ErrorAttributes(), "Error updating OpenStack Neutron Port")
 		}
 	}
 
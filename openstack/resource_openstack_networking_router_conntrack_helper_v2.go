@@ -0,0 +1,174 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkingRouterConntrackHelperV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingRouterConntrackHelperV2Create,
+		ReadContext:   resourceNetworkingRouterConntrackHelperV2Read,
+		UpdateContext: resourceNetworkingRouterConntrackHelperV2Update,
+		DeleteContext: resourceNetworkingRouterConntrackHelperV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"router_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+
+			"helper": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingRouterConntrackHelperV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	routerID := d.Get("router_id").(string)
+	createOpts := networkingRouterConntrackHelperV2CreateOpts{
+		Protocol: d.Get("protocol").(string),
+		Port:     d.Get("port").(int),
+		Helper:   d.Get("helper").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_networking_router_conntrack_helper_v2 create options: %#v", createOpts)
+
+	helper, err := networkingRouterConntrackHelperV2Create(ctx, networkingClient, routerID, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_networking_router_conntrack_helper_v2 for router %s: %s", routerID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", routerID, helper.ID))
+
+	log.Printf("[DEBUG] Created openstack_networking_router_conntrack_helper_v2 %s: %#v", d.Id(), helper)
+
+	return resourceNetworkingRouterConntrackHelperV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingRouterConntrackHelperV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	routerID, id, err := parsePairedIDs(d.Id(), "openstack_networking_router_conntrack_helper_v2")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	helper, err := networkingRouterConntrackHelperV2Get(ctx, networkingClient, routerID, id).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error getting openstack_networking_router_conntrack_helper_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_router_conntrack_helper_v2 %s: %#v", d.Id(), helper)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("router_id", routerID)
+	d.Set("protocol", helper.Protocol)
+	d.Set("port", helper.Port)
+	d.Set("helper", helper.Helper)
+
+	return nil
+}
+
+func resourceNetworkingRouterConntrackHelperV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	routerID, id, err := parsePairedIDs(d.Id(), "openstack_networking_router_conntrack_helper_v2")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var updateOpts networkingRouterConntrackHelperV2UpdateOpts
+
+	if d.HasChange("protocol") {
+		protocol := d.Get("protocol").(string)
+		updateOpts.Protocol = &protocol
+	}
+
+	if d.HasChange("port") {
+		port := d.Get("port").(int)
+		updateOpts.Port = &port
+	}
+
+	if d.HasChange("helper") {
+		helper := d.Get("helper").(string)
+		updateOpts.Helper = &helper
+	}
+
+	if updateOpts != (networkingRouterConntrackHelperV2UpdateOpts{}) {
+		log.Printf("[DEBUG] openstack_networking_router_conntrack_helper_v2 %s update options: %#v", d.Id(), updateOpts)
+
+		_, err = networkingRouterConntrackHelperV2Update(ctx, networkingClient, routerID, id, updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_networking_router_conntrack_helper_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkingRouterConntrackHelperV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingRouterConntrackHelperV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	routerID, id, err := parsePairedIDs(d.Id(), "openstack_networking_router_conntrack_helper_v2")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := networkingRouterConntrackHelperV2Delete(ctx, networkingClient, routerID, id).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_networking_router_conntrack_helper_v2"))
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNetworkingV2RouterConntrackHelper_basic(t *testing.T) {
+	var helper networkingRouterConntrackHelperV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckConntrackHelper(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2RouterConntrackHelperDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2RouterConntrackHelperBasic("tcp", 21, "ftp"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RouterConntrackHelperExists(t.Context(), "openstack_networking_router_conntrack_helper_v2.helper_1", &helper),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_router_conntrack_helper_v2.helper_1", "router_id",
+						"openstack_networking_router_v2.router_1", "id"),
+					resource.TestCheckResourceAttr("openstack_networking_router_conntrack_helper_v2.helper_1", "protocol", "tcp"),
+					resource.TestCheckResourceAttr("openstack_networking_router_conntrack_helper_v2.helper_1", "port", "21"),
+					resource.TestCheckResourceAttr("openstack_networking_router_conntrack_helper_v2.helper_1", "helper", "ftp"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2RouterConntrackHelperBasic("udp", 69, "tftp"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("openstack_networking_router_conntrack_helper_v2.helper_1", "protocol", "udp"),
+					resource.TestCheckResourceAttr("openstack_networking_router_conntrack_helper_v2.helper_1", "port", "69"),
+					resource.TestCheckResourceAttr("openstack_networking_router_conntrack_helper_v2.helper_1", "helper", "tftp"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2RouterConntrackHelperExists(ctx context.Context, n string, helper *networkingRouterConntrackHelperV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		routerID, id, err := parsePairedIDs(rs.Primary.ID, "openstack_networking_router_conntrack_helper_v2")
+		if err != nil {
+			return err
+		}
+
+		found, err := networkingRouterConntrackHelperV2Get(ctx, networkingClient, routerID, id).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != id {
+			return errors.New("Conntrack helper not found")
+		}
+
+		*helper = *found
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2RouterConntrackHelperDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_networking_router_conntrack_helper_v2" {
+				continue
+			}
+
+			routerID, id, err := parsePairedIDs(rs.Primary.ID, "openstack_networking_router_conntrack_helper_v2")
+			if err != nil {
+				return err
+			}
+
+			_, err = networkingRouterConntrackHelperV2Get(ctx, networkingClient, routerID, id).Extract()
+			if err == nil {
+				return errors.New("Conntrack helper still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccNetworkingV2RouterConntrackHelperBasic(protocol string, port int, helper string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_router_v2" "router_1" {
+  name           = "router_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_router_conntrack_helper_v2" "helper_1" {
+  router_id = openstack_networking_router_v2.router_1.id
+  protocol  = "%s"
+  port      = %d
+  helper    = "%s"
+}
+`, protocol, port, helper)
+}
@@ -116,6 +116,56 @@ func resourceNetworkingRouterV2() *schema.Resource {
 				RequiredWith:  []string{"external_network_id"},
 			},
 
+			"additional_external_gateway": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				RequiredWith: []string{"external_network_id"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"enable_snat": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"external_fixed_ip": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"subnet_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"ip_address": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"enable_default_route_ecmp": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"enable_default_route_bfd": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
 			"tenant_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -177,13 +227,13 @@ func resourceNetworkingRouterV2Create(ctx context.Context, d *schema.ResourceDat
 	}
 
 	createOpts := RouterCreateOpts{
-		routers.CreateOpts{
+		CreateOpts: routers.CreateOpts{
 			Name:                  d.Get("name").(string),
 			Description:           d.Get("description").(string),
 			TenantID:              d.Get("tenant_id").(string),
 			AvailabilityZoneHints: resourceNetworkingAvailabilityZoneHintsV2(d),
 		},
-		MapValueSpecs(d),
+		ValueSpecs: MapValueSpecs(d),
 	}
 
 	if asuRaw, ok := d.GetOk("admin_state_up"); ok {
@@ -191,6 +241,16 @@ func resourceNetworkingRouterV2Create(ctx context.Context, d *schema.ResourceDat
 		createOpts.AdminStateUp = &asu
 	}
 
+	if v, ok := getOkExists(d, "enable_default_route_ecmp"); ok {
+		ecmp := v.(bool)
+		createOpts.EnableDefaultRouteECMP = &ecmp
+	}
+
+	if v, ok := getOkExists(d, "enable_default_route_bfd"); ok {
+		bfd := v.(bool)
+		createOpts.EnableDefaultRouteBFD = &bfd
+	}
+
 	if dRaw, ok := getOkExists(d, "distributed"); ok {
 		d := dRaw.(bool)
 		createOpts.Distributed = &d
@@ -306,6 +366,16 @@ func resourceNetworkingRouterV2Create(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	additionalGateways := expandNetworkingRouterAdditionalExternalGatewaysV2(d, d.Get("additional_external_gateway").([]any))
+	if len(additionalGateways) > 0 {
+		log.Printf("[DEBUG] Adding additional external gateways to openstack_networking_router_v2 %s: %#v", r.ID, additionalGateways)
+
+		_, err = networkingRouterV2AddExternalGateways(ctx, networkingClient, r.ID, additionalGateways).Extract()
+		if err != nil {
+			return diag.Errorf("Error adding additional external gateways to openstack_networking_router_v2 %s: %s", r.ID, err)
+		}
+	}
+
 	tags := networkingV2AttributesTags(d)
 	if len(tags) > 0 {
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
@@ -331,7 +401,9 @@ func resourceNetworkingRouterV2Read(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	r, err := routers.Get(ctx, networkingClient, d.Id()).Extract()
+	res := routers.Get(ctx, networkingClient, d.Id())
+
+	r, err := res.Extract()
 	if err != nil {
 		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
 			d.SetId("")
@@ -342,6 +414,13 @@ func resourceNetworkingRouterV2Read(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("Error retrieving openstack_networking_router_v2: %s", err)
 	}
 
+	var multihoming networkingRouterV2Multihoming
+
+	err = res.ExtractIntoStructPtr(&multihoming, "router")
+	if err != nil {
+		return diag.Errorf("Error extracting openstack_networking_router_v2 %s external gateways: %s", d.Id(), err)
+	}
+
 	log.Printf("[DEBUG] Retrieved openstack_networking_router_v2 %s: %#v", d.Id(), r)
 
 	// Basic settings.
@@ -367,6 +446,22 @@ func resourceNetworkingRouterV2Read(ctx context.Context, d *schema.ResourceData,
 		log.Printf("[DEBUG] Unable to set openstack_networking_router_v2 %s external_fixed_ip: %s", d.Id(), err)
 	}
 
+	// The first external gateway is the one of external_network_id, the
+	// additional ones are kept in the order of the configuration.
+	externalGateways := multihoming.ExternalGateways
+	if len(externalGateways) > 1 {
+		current := expandNetworkingRouterStateAdditionalExternalGatewaysV2(d.Get("additional_external_gateway").([]any))
+		externalGateways = append(externalGateways[:1:1], orderNetworkingRouterAdditionalExternalGatewaysV2(current, externalGateways[1:])...)
+	}
+
+	additionalGateways := flattenNetworkingRouterAdditionalExternalGatewaysV2(externalGateways)
+	if err = d.Set("additional_external_gateway", additionalGateways); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_networking_router_v2 %s additional_external_gateway: %s", d.Id(), err)
+	}
+
+	d.Set("enable_default_route_ecmp", multihoming.EnableDefaultRouteECMP)
+	d.Set("enable_default_route_bfd", multihoming.EnableDefaultRouteBFD)
+
 	return nil
 }
 
@@ -384,7 +479,7 @@ func resourceNetworkingRouterV2Update(ctx context.Context, d *schema.ResourceDat
 
 	var hasChange bool
 
-	var updateOpts routers.UpdateOpts
+	var updateOpts RouterUpdateOpts
 
 	if d.HasChange("name") {
 		hasChange = true
@@ -403,6 +498,18 @@ func resourceNetworkingRouterV2Update(ctx context.Context, d *schema.ResourceDat
 		updateOpts.AdminStateUp = &asu
 	}
 
+	if d.HasChange("enable_default_route_ecmp") {
+		hasChange = true
+		ecmp := d.Get("enable_default_route_ecmp").(bool)
+		updateOpts.EnableDefaultRouteECMP = &ecmp
+	}
+
+	if d.HasChange("enable_default_route_bfd") {
+		hasChange = true
+		bfd := d.Get("enable_default_route_bfd").(bool)
+		updateOpts.EnableDefaultRouteBFD = &bfd
+	}
+
 	// Gateway settings.
 	var updateGatewaySettings bool
 
@@ -453,6 +560,41 @@ func resourceNetworkingRouterV2Update(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if d.HasChange("additional_external_gateway") {
+		o, n := d.GetChange("additional_external_gateway")
+		oldGateways := expandNetworkingRouterStateAdditionalExternalGatewaysV2(o.([]any))
+		newGateways := expandNetworkingRouterAdditionalExternalGatewaysV2(d, n.([]any))
+
+		remove, update, add := networkingRouterV2ExternalGatewaysChanges(oldGateways, newGateways)
+
+		if len(remove) > 0 {
+			log.Printf("[DEBUG] Removing external gateways from openstack_networking_router_v2 %s: %#v", d.Id(), remove)
+
+			_, err = networkingRouterV2RemoveExternalGateways(ctx, networkingClient, d.Id(), remove).Extract()
+			if err != nil {
+				return diag.Errorf("Error removing external gateways from openstack_networking_router_v2 %s: %s", d.Id(), err)
+			}
+		}
+
+		if len(update) > 0 {
+			log.Printf("[DEBUG] Updating external gateways of openstack_networking_router_v2 %s: %#v", d.Id(), update)
+
+			_, err = networkingRouterV2UpdateExternalGateways(ctx, networkingClient, d.Id(), update).Extract()
+			if err != nil {
+				return diag.Errorf("Error updating external gateways of openstack_networking_router_v2 %s: %s", d.Id(), err)
+			}
+		}
+
+		if len(add) > 0 {
+			log.Printf("[DEBUG] Adding external gateways to openstack_networking_router_v2 %s: %#v", d.Id(), add)
+
+			_, err = networkingRouterV2AddExternalGateways(ctx, networkingClient, d.Id(), add).Extract()
+			if err != nil {
+				return diag.Errorf("Error adding external gateways to openstack_networking_router_v2 %s: %s", d.Id(), err)
+			}
+		}
+	}
+
 	// Next, perform any required updates to the tags.
 	if d.HasChange("tags") {
 		tags := networkingV2UpdateAttributesTags(d)
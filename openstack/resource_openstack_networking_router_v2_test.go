@@ -220,6 +220,95 @@ func TestAccNetworkingV2Router_extIPAddress(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2Router_multihoming(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckMultihoming(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2RouterDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2RouterMultihoming(1, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "additional_external_gateway.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "additional_external_gateway.0.network_id", osExtGwID),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "additional_external_gateway.0.external_fixed_ip.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "enable_default_route_ecmp", "false"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2RouterMultihoming(2, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "additional_external_gateway.#", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "enable_default_route_ecmp", "true"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2RouterMultihoming(0, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "additional_external_gateway.#", "0"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "external_network_id", osExtGwID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2Router_multihomingReorder(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckMultihoming(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2RouterDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2RouterMultihomingReorder(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "additional_external_gateway.#", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "additional_external_gateway.0.network_id", osExtGwID),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_router_v2.router_1", "additional_external_gateway.1.network_id",
+						"openstack_networking_network_v2.network_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_router_v2.router_1", "additional_external_gateway.1.external_fixed_ip.0.subnet_id",
+						"openstack_networking_subnet_v2.subnet_1", "id"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2RouterMultihomingReorder(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "additional_external_gateway.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_router_v2.router_1", "additional_external_gateway.0.network_id",
+						"openstack_networking_network_v2.network_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_router_v2.router_1", "additional_external_gateway.0.external_fixed_ip.0.subnet_id",
+						"openstack_networking_subnet_v2.subnet_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "additional_external_gateway.1.network_id", osExtGwID),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkingV2RouterDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := testAccProvider.Meta().(*Config)
@@ -456,3 +545,66 @@ resource "openstack_networking_router_v2" "router_1" {
 }
 `, osExtGwID, osExtGwID)
 }
+
+func testAccNetworkingV2RouterMultihoming(additionalGateways int, ecmp bool) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_router_v2" "router_1" {
+  name                      = "router_1"
+  admin_state_up            = "true"
+  external_network_id       = "%s"
+  enable_default_route_ecmp = %t
+
+  dynamic "additional_external_gateway" {
+    for_each = range(%d)
+
+    content {
+      network_id = "%s"
+    }
+  }
+}
+`, osExtGwID, ecmp, additionalGateways, osExtGwID)
+}
+
+func testAccNetworkingV2RouterMultihomingReorder(reverse bool) string {
+	gateways := []string{
+		fmt.Sprintf(`
+  additional_external_gateway {
+    network_id = "%s"
+  }
+`, osExtGwID),
+		`
+  additional_external_gateway {
+    network_id = openstack_networking_network_v2.network_1.id
+
+    external_fixed_ip {
+      subnet_id = openstack_networking_subnet_v2.subnet_1.id
+    }
+  }
+`,
+	}
+
+	if reverse {
+		gateways[0], gateways[1] = gateways[1], gateways[0]
+	}
+
+	return fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name           = "network_1"
+  admin_state_up = "true"
+  external       = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name       = "subnet_1"
+  cidr       = "192.168.199.0/24"
+  ip_version = 4
+  network_id = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_networking_router_v2" "router_1" {
+  name                = "router_1"
+  admin_state_up      = "true"
+  external_network_id = "%s"
+%s%s}
+`, osExtGwID, gateways[0], gateways[1])
+}
@@ -106,6 +106,8 @@ func resourceNetworkingSecGroupV2Create(ctx context.Context, d *schema.ResourceD
 		return diag.Errorf("Error creating openstack_networking_secgroup_v2: %s", err)
 	}
 
+	config.lookupCache.invalidate(lookupCacheSecGroup, sg.ID, sg.Name)
+
 	// Delete the default security group rules if it has been requested.
 	deleteDefaultRules := d.Get("delete_default_rules").(bool)
 	if deleteDefaultRules {
@@ -218,6 +220,9 @@ func resourceNetworkingSecGroupV2Update(ctx context.Context, d *schema.ResourceD
 		log.Printf("[DEBUG] Set tags %s on openstack_networking_secgroup_v2 %s", tags, d.Id())
 	}
 
+	// The security group data source caches the whole security group.
+	config.lookupCache.invalidate(lookupCacheSecGroup, d.Id(), d.Get("name").(string))
+
 	return resourceNetworkingSecGroupV2Read(ctx, d, meta)
 }
 
@@ -239,6 +244,8 @@ func resourceNetworkingSecGroupV2Delete(ctx context.Context, d *schema.ResourceD
 	}
 
 	_, err = stateConf.WaitForStateContext(ctx)
+	config.lookupCache.invalidate(lookupCacheSecGroup, d.Id(), "")
+
 	if err != nil {
 		return diag.Errorf("Error deleting openstack_networking_secgroup_v2: %s", err)
 	}
@@ -288,7 +288,7 @@ func resourceNetworkingSubnetV2Create(ctx context.Context, d *schema.ResourceDat
 
 	s, err := subnets.Create(ctx, networkingClient, createOpts).Extract()
 	if err != nil {
-		return diag.Errorf("Error creating openstack_networking_subnet_v2: %s", err)
+		return diagOpenStackAttributeErrorf(err, networkingSubnetV2ErrorAttributes(), "Error creating openstack_networking_subnet_v2")
 	}
 
 	log.Printf("[DEBUG] Waiting for openstack_networking_subnet_v2 %s to become available", s.ID)
@@ -313,7 +313,7 @@ func resourceNetworkingSubnetV2Create(ctx context.Context, d *schema.ResourceDat
 
 		tags, err := attributestags.ReplaceAll(ctx, networkingClient, "subnets", s.ID, tagOpts).Extract()
 		if err != nil {
-			return diag.Errorf("Error creating tags on openstack_networking_subnet_v2 %s: %s", s.ID, err)
+			return diagOpenStackErrorf(err, "Error creating tags on openstack_networking_subnet_v2 %s", s.ID)
 		}
 
 		log.Printf("[DEBUG] Set tags %s on openstack_networking_subnet_v2 %s", tags, s.ID)
@@ -464,7 +464,7 @@ func resourceNetworkingSubnetV2Update(ctx context.Context, d *schema.ResourceDat
 
 		_, err = subnets.Update(ctx, networkingClient, d.Id(), updateOpts).Extract()
 		if err != nil {
-			return diag.Errorf("Error updating OpenStack Neutron openstack_networking_subnet_v2 %s: %s", d.Id(), err)
+			return diagOpenStackAttributeErrorf(err, networkingSubnetV2ErrorAttributes(), "Error updating OpenStack Neutron openstack_networking_subnet_v2 %s", d.Id())
 		}
 	}
 
@@ -474,7 +474,7 @@ func resourceNetworkingSubnetV2Update(ctx context.Context, d *schema.ResourceDat
 
 		tags, err := attributestags.ReplaceAll(ctx, networkingClient, "subnets", d.Id(), tagOpts).Extract()
 		if err != nil {
-			return diag.Errorf("Error updating tags on openstack_networking_subnet_v2 %s: %s", d.Id(), err)
+			return diagOpenStackErrorf(err, "Error updating tags on openstack_networking_subnet_v2 %s", d.Id())
 		}
 
 		log.Printf("[DEBUG] Updated tags %s on openstack_networking_subnet_v2 %s", tags, d.Id())
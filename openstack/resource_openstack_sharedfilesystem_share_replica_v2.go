@@ -0,0 +1,287 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/replicas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSharedFilesystemShareReplicaV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSharedFilesystemShareReplicaV2Create,
+		ReadContext:   resourceSharedFilesystemShareReplicaV2Read,
+		UpdateContext: resourceSharedFilesystemShareReplicaV2Update,
+		DeleteContext: resourceSharedFilesystemShareReplicaV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"share_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"share_network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"promote": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"quiesce_wait_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"replica_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"share_server_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareReplicaV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareReplicaMicroversion
+
+	createOpts := replicas.CreateOpts{
+		ShareID:          d.Get("share_id").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+		ShareNetworkID:   d.Get("share_network_id").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_sharedfilesystem_share_replica_v2 create options: %#v", createOpts)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	var replica *replicas.Replica
+
+	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		replica, err = replicas.Create(ctx, sfsClient, createOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("Error creating openstack_sharedfilesystem_share_replica_v2: %s", err)
+	}
+
+	d.SetId(replica.ID)
+
+	stateConf := &retry.StateChangeConf{
+		Target:     []string{"available"},
+		Pending:    []string{"creating"},
+		Refresh:    sharedFilesystemShareReplicaV2StateRefreshFunc(ctx, sfsClient, replica.ID),
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for openstack_sharedfilesystem_share_replica_v2 %s to become available: %s", replica.ID, err)
+	}
+
+	if d.Get("promote").(bool) {
+		err = sharedFilesystemShareReplicaV2Promote(ctx, d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceSharedFilesystemShareReplicaV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareReplicaV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareReplicaMicroversion
+
+	replica, err := replicas.Get(ctx, sfsClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_share_replica_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_share_replica_v2 %s: %#v", d.Id(), replica)
+
+	d.Set("share_id", replica.ShareID)
+	d.Set("availability_zone", replica.AvailabilityZone)
+	d.Set("share_network_id", replica.ShareNetworkID)
+	d.Set("status", replica.Status)
+	d.Set("replica_state", replica.State)
+	d.Set("host", replica.Host)
+	d.Set("share_server_id", replica.ShareServerID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceSharedFilesystemShareReplicaV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if d.HasChange("promote") && d.Get("promote").(bool) {
+		err := sharedFilesystemShareReplicaV2Promote(ctx, d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceSharedFilesystemShareReplicaV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareReplicaV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareReplicaMicroversion
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+
+	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		err := replicas.Delete(ctx, sfsClient, d.Id()).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_sharedfilesystem_share_replica_v2"))
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Target:     []string{"deleted"},
+		Pending:    []string{"available", "deleting"},
+		Refresh:    sharedFilesystemShareReplicaV2StateRefreshFunc(ctx, sfsClient, d.Id()),
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for openstack_sharedfilesystem_share_replica_v2 %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// sharedFilesystemShareReplicaV2Promote promotes the replica to be the active
+// replica of its share, unless it already is.
+func sharedFilesystemShareReplicaV2Promote(ctx context.Context, d *schema.ResourceData, meta any) error {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %w", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareReplicaMicroversion
+
+	replica, err := replicas.Get(ctx, sfsClient, d.Id()).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_sharedfilesystem_share_replica_v2 %s: %w", d.Id(), err)
+	}
+
+	if replica.State == "active" {
+		log.Printf("[DEBUG] openstack_sharedfilesystem_share_replica_v2 %s is already active", d.Id())
+
+		return nil
+	}
+
+	var promoteOpts replicas.PromoteOpts
+
+	if v, ok := d.GetOk("quiesce_wait_time"); ok {
+		sfsClient.Microversion = sharedFilesystemV2ShareReplicaQuiesceMicroversion
+		promoteOpts.QuiesceWaitTime = v.(int)
+	}
+
+	log.Printf("[DEBUG] Promoting openstack_sharedfilesystem_share_replica_v2 %s with options: %#v", d.Id(), promoteOpts)
+
+	err = replicas.Promote(ctx, sfsClient, d.Id(), promoteOpts).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error promoting openstack_sharedfilesystem_share_replica_v2 %s: %w", d.Id(), err)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Target:     []string{"promoted"},
+		Pending:    []string{"available", "replication_change"},
+		Refresh:    sharedFilesystemShareReplicaV2PromoteRefreshFunc(ctx, sfsClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      0,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_sharedfilesystem_share_replica_v2 %s to become active: %w", d.Id(), err)
+	}
+
+	return nil
+}
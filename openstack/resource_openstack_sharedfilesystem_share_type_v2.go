@@ -0,0 +1,271 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/sharetypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSharedFilesystemShareTypeV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSharedFilesystemShareTypeV2Create,
+		ReadContext:   resourceSharedFilesystemShareTypeV2Read,
+		UpdateContext: resourceSharedFilesystemShareTypeV2Update,
+		DeleteContext: resourceSharedFilesystemShareTypeV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"is_public": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"driver_handles_share_servers": {
+				Type:     schema.TypeBool,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"extra_specs": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"access_project_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareTypeV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareTypeMicroversion
+
+	isPublic := d.Get("is_public").(bool)
+	accessProjectIDs := d.Get("access_project_ids").(*schema.Set)
+
+	if isPublic && accessProjectIDs.Len() > 0 {
+		return diag.Errorf("access_project_ids can only be set for openstack_sharedfilesystem_share_type_v2 which are not public")
+	}
+
+	createOpts := sharedFilesystemShareTypeV2CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		IsPublic:    &isPublic,
+		ExtraSpecs: expandSharedFilesystemShareTypeV2ExtraSpecs(
+			d.Get("extra_specs").(map[string]any),
+			d.Get("driver_handles_share_servers").(bool),
+		),
+	}
+
+	log.Printf("[DEBUG] openstack_sharedfilesystem_share_type_v2 create options: %#v", createOpts)
+
+	shareType, err := sharedFilesystemShareTypeV2Create(ctx, sfsClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_sharedfilesystem_share_type_v2 %s: %s", createOpts.Name, err)
+	}
+
+	d.SetId(shareType.ID)
+
+	for _, projectID := range expandToStringSlice(accessProjectIDs.List()) {
+		err = sharetypes.AddAccess(ctx, sfsClient, d.Id(), sharetypes.AccessOpts{Project: projectID}).ExtractErr()
+		if err != nil {
+			return diag.Errorf("Error granting openstack_sharedfilesystem_share_type_v2 %s access to project %s: %s", d.Id(), projectID, err)
+		}
+	}
+
+	return resourceSharedFilesystemShareTypeV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareTypeV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareTypeMicroversion
+
+	shareType, err := sharedFilesystemShareTypeV2Get(ctx, sfsClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_share_type_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_share_type_v2 %s: %#v", d.Id(), shareType)
+
+	extraSpecs, dhss := flattenSharedFilesystemShareTypeV2ExtraSpecs(shareType.ExtraSpecs)
+
+	d.Set("name", shareType.Name)
+	d.Set("description", shareType.Description)
+	d.Set("is_public", shareType.IsPublic)
+	d.Set("driver_handles_share_servers", dhss)
+	d.Set("extra_specs", extraSpecs)
+	d.Set("region", GetRegion(d, config))
+
+	// Public share types have no access list.
+	if shareType.IsPublic {
+		d.Set("access_project_ids", nil)
+
+		return nil
+	}
+
+	access, err := sharetypes.ShowAccess(ctx, sfsClient, d.Id()).Extract()
+	if err != nil {
+		return diag.Errorf("Error retrieving access of openstack_sharedfilesystem_share_type_v2 %s: %s", d.Id(), err)
+	}
+
+	projectIDs := make([]string, 0, len(access))
+	for _, v := range access {
+		projectIDs = append(projectIDs, v.ProjectID)
+	}
+
+	d.Set("access_project_ids", projectIDs)
+
+	return nil
+}
+
+func resourceSharedFilesystemShareTypeV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareTypeMicroversion
+
+	if d.Get("is_public").(bool) && d.Get("access_project_ids").(*schema.Set).Len() > 0 {
+		return diag.Errorf("access_project_ids can only be set for openstack_sharedfilesystem_share_type_v2 which are not public")
+	}
+
+	var updateOpts sharedFilesystemShareTypeV2UpdateOpts
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("is_public") {
+		isPublic := d.Get("is_public").(bool)
+		updateOpts.IsPublic = &isPublic
+	}
+
+	if updateOpts != (sharedFilesystemShareTypeV2UpdateOpts{}) {
+		log.Printf("[DEBUG] openstack_sharedfilesystem_share_type_v2 %s update options: %#v", d.Id(), updateOpts)
+
+		sfsClient.Microversion = sharedFilesystemV2ShareTypeUpdateMicroversion
+
+		_, err = sharedFilesystemShareTypeV2Update(ctx, sfsClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_sharedfilesystem_share_type_v2 %s: %s", d.Id(), err)
+		}
+
+		sfsClient.Microversion = sharedFilesystemV2ShareTypeMicroversion
+	}
+
+	if d.HasChange("extra_specs") {
+		o, n := d.GetChange("extra_specs")
+		oldExtraSpecs := o.(map[string]any)
+		newExtraSpecs := n.(map[string]any)
+
+		for key := range oldExtraSpecs {
+			if _, ok := newExtraSpecs[key]; ok {
+				continue
+			}
+
+			err = sharetypes.UnsetExtraSpecs(ctx, sfsClient, d.Id(), key).ExtractErr()
+			if err != nil {
+				return diag.Errorf("Error deleting extra_spec %s from openstack_sharedfilesystem_share_type_v2 %s: %s", key, d.Id(), err)
+			}
+		}
+
+		if len(newExtraSpecs) > 0 {
+			setOpts := sharetypes.SetExtraSpecsOpts{ExtraSpecs: newExtraSpecs}
+
+			_, err = sharetypes.SetExtraSpecs(ctx, sfsClient, d.Id(), setOpts).Extract()
+			if err != nil {
+				return diag.Errorf("Error setting extra_specs for openstack_sharedfilesystem_share_type_v2 %s: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("access_project_ids") {
+		o, n := d.GetChange("access_project_ids")
+		oldProjectIDs := o.(*schema.Set)
+		newProjectIDs := n.(*schema.Set)
+
+		for _, projectID := range expandToStringSlice(oldProjectIDs.Difference(newProjectIDs).List()) {
+			err = sharetypes.RemoveAccess(ctx, sfsClient, d.Id(), sharetypes.AccessOpts{Project: projectID}).ExtractErr()
+			if err != nil && CheckDeleted(d, err, "") != nil {
+				return diag.Errorf("Error revoking openstack_sharedfilesystem_share_type_v2 %s access from project %s: %s", d.Id(), projectID, err)
+			}
+		}
+
+		for _, projectID := range expandToStringSlice(newProjectIDs.Difference(oldProjectIDs).List()) {
+			err = sharetypes.AddAccess(ctx, sfsClient, d.Id(), sharetypes.AccessOpts{Project: projectID}).ExtractErr()
+			if err != nil {
+				return diag.Errorf("Error granting openstack_sharedfilesystem_share_type_v2 %s access to project %s: %s", d.Id(), projectID, err)
+			}
+		}
+	}
+
+	return resourceSharedFilesystemShareTypeV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareTypeV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareTypeMicroversion
+
+	err = sharetypes.Delete(ctx, sfsClient, d.Id()).ExtractErr()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_sharedfilesystem_share_type_v2"))
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSFSV2ShareType_basic(t *testing.T) {
+	var shareType sharedFilesystemShareTypeV2
+
+	resourceName := "openstack_sharedfilesystem_share_type_v2.share_type_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareTypeDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareTypeConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareTypeExists(t.Context(), resourceName, &shareType),
+					resource.TestCheckResourceAttr(resourceName, "name", "share_type_1"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test share type"),
+					resource.TestCheckResourceAttr(resourceName, "is_public", "true"),
+					resource.TestCheckResourceAttr(resourceName, "driver_handles_share_servers", "false"),
+					resource.TestCheckResourceAttr(resourceName, "extra_specs.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "extra_specs.snapshot_support", "True"),
+				),
+			},
+			{
+				Config: testAccSFSV2ShareTypeConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareTypeExists(t.Context(), resourceName, &shareType),
+					resource.TestCheckResourceAttr(resourceName, "name", "share_type_1_updated"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "is_public", "false"),
+					resource.TestCheckResourceAttr(resourceName, "extra_specs.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "extra_specs.replication_type", "readable"),
+					resource.TestCheckResourceAttr(resourceName, "access_project_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "access_project_ids.*", "openstack_identity_project_v3.project_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSFSV2ShareTypeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		sfsClient, err := config.SharedfilesystemV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %w", err)
+		}
+
+		sfsClient.Microversion = sharedFilesystemV2ShareTypeMicroversion
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_sharedfilesystem_share_type_v2" {
+				continue
+			}
+
+			_, err := sharedFilesystemShareTypeV2Get(ctx, sfsClient, rs.Primary.ID).Extract()
+			if err == nil {
+				return fmt.Errorf("Manila share type still exists: %s", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSFSV2ShareTypeExists(ctx context.Context, n string, shareType *sharedFilesystemShareTypeV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		sfsClient, err := config.SharedfilesystemV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %w", err)
+		}
+
+		sfsClient.Microversion = sharedFilesystemV2ShareTypeMicroversion
+
+		found, err := sharedFilesystemShareTypeV2Get(ctx, sfsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Share type not found")
+		}
+
+		*shareType = *found
+
+		return nil
+	}
+}
+
+const testAccSFSV2ShareTypeConfigBasic = `
+resource "openstack_sharedfilesystem_share_type_v2" "share_type_1" {
+  name                         = "share_type_1"
+  description                  = "test share type"
+  driver_handles_share_servers = false
+
+  extra_specs = {
+    snapshot_support = "True"
+  }
+}
+`
+
+const testAccSFSV2ShareTypeConfigUpdate = `
+resource "openstack_identity_project_v3" "project_1" {
+  name = "share_type_project_1"
+}
+
+resource "openstack_sharedfilesystem_share_type_v2" "share_type_1" {
+  name                         = "share_type_1_updated"
+  is_public                    = false
+  driver_handles_share_servers = false
+  access_project_ids           = [openstack_identity_project_v3.project_1.id]
+
+  extra_specs = {
+    replication_type = "readable"
+  }
+}
+`
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"strconv"
 	"time"
 
 	"github.com/gophercloud/gophercloud/v2"
@@ -115,11 +114,27 @@ func resourceSharedFilesystemShareV2() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"share_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"revert_to_snapshot_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"export_locations": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"path": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -128,10 +143,19 @@ func resourceSharedFilesystemShareV2() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"is_admin_only": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
 					},
 				},
 			},
 
+			"preferred_export_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"has_replicas": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -191,12 +215,18 @@ func resourceSharedFilesystemShareV2Create(ctx context.Context, d *schema.Resour
 		Metadata:         metadata,
 		ShareNetworkID:   d.Get("share_network_id").(string),
 		AvailabilityZone: d.Get("availability_zone").(string),
+		ShareGroupID:     d.Get("share_group_id").(string),
 	}
 
 	if v, ok := getOkExists(d, "share_type"); ok {
 		createOpts.ShareType = v.(string)
 	}
 
+	// Share groups are no longer experimental since 2.55.
+	if createOpts.ShareGroupID != "" {
+		sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+	}
+
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 
 	timeout := d.Timeout(schema.TimeoutCreate)
@@ -224,6 +254,8 @@ func resourceSharedFilesystemShareV2Create(ctx context.Context, d *schema.Resour
 		for k, msg := range detailedErr {
 			return diag.Errorf("Error creating share: %s (%d): %s", k, msg.Code, msg.Message)
 		}
+
+		return diag.Errorf("Error creating share: %s", err)
 	}
 
 	d.SetId(share.ID)
@@ -245,7 +277,10 @@ func resourceSharedFilesystemShareV2Read(ctx context.Context, d *schema.Resource
 		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
 	}
 
-	sfsClient.Microversion = minManilaShareMicroversion
+	sfsClient.Microversion, err = sharedFilesystemShareV2ReadMicroversion(ctx, sfsClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	share, err := shares.Get(ctx, sfsClient, d.Id()).Extract()
 	if err != nil {
@@ -261,18 +296,13 @@ func resourceSharedFilesystemShareV2Read(ctx context.Context, d *schema.Resource
 
 	log.Printf("[DEBUG] Retrieved share's export_locations %s: %#v", d.Id(), exportLocationsRaw)
 
-	exportLocations := make([]map[string]string, 0, len(exportLocationsRaw))
-	for _, v := range exportLocationsRaw {
-		exportLocations = append(exportLocations, map[string]string{
-			"path":      v.Path,
-			"preferred": strconv.FormatBool(v.Preferred),
-		})
-	}
-
+	exportLocations := flattenSharedFilesystemShareV2ExportLocations(exportLocationsRaw)
 	if err = d.Set("export_locations", exportLocations); err != nil {
 		log.Printf("[DEBUG] Unable to set export_locations: %s", err)
 	}
 
+	d.Set("preferred_export_location", sharedFilesystemShareV2PreferredExportLocation(exportLocationsRaw))
+
 	d.Set("name", share.Name)
 	d.Set("description", share.Description)
 	d.Set("share_proto", share.ShareProto)
@@ -283,6 +313,7 @@ func resourceSharedFilesystemShareV2Read(ctx context.Context, d *schema.Resource
 	d.Set("all_metadata", share.Metadata)
 	d.Set("share_network_id", share.ShareNetworkID)
 	d.Set("availability_zone", share.AvailabilityZone)
+	d.Set("share_group_id", share.ShareGroupID)
 	// Computed
 	d.Set("region", GetRegion(d, config))
 	d.Set("project_id", share.ProjectID)
@@ -408,6 +439,8 @@ func resourceSharedFilesystemShareV2Update(ctx context.Context, d *schema.Resour
 			for k, msg := range detailedErr {
 				return diag.Errorf("Unable to resize %s share: %s (%d): %s", d.Id(), k, msg.Code, msg.Message)
 			}
+
+			return diag.Errorf("Unable to resize %s share: %s", d.Id(), err)
 		}
 
 		// Wait for share to become active before continuing
@@ -443,11 +476,13 @@ func resourceSharedFilesystemShareV2Update(ctx context.Context, d *schema.Resour
 			}
 		}
 
-		for newKey, newValue := range newMetadata {
-			metadataToUpdate[newKey] = newValue.(string)
+		for key, value := range existingMetadata {
+			metadataToUpdate[key] = value.(string)
 		}
 
-		for newKey, newValue := range existingMetadata {
+		// Values from the configuration take precedence over the
+		// previously retrieved ones.
+		for newKey, newValue := range newMetadata {
 			metadataToUpdate[newKey] = newValue.(string)
 		}
 
@@ -464,6 +499,44 @@ func resourceSharedFilesystemShareV2Update(ctx context.Context, d *schema.Resour
 		}
 	}
 
+	if d.HasChange("revert_to_snapshot_id") {
+		if snapshotID := d.Get("revert_to_snapshot_id").(string); snapshotID != "" {
+			sfsClient.Microversion = sharedFilesystemV2ShareRevertMicroversion
+
+			revertOpts := shares.RevertOpts{SnapshotID: snapshotID}
+			log.Printf("[DEBUG] Reverting share %s with options: %#v", d.Id(), revertOpts)
+
+			err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+				err := shares.Revert(ctx, sfsClient, d.Id(), revertOpts).ExtractErr()
+				if err != nil {
+					return checkForRetryableError(err)
+				}
+
+				return nil
+			})
+			if err != nil {
+				detailedErr := errors.ErrorDetails{}
+
+				e := errors.ExtractErrorInto(err, &detailedErr)
+				if e != nil {
+					return diag.Errorf("Unable to revert %s share to %s snapshot: %s: %s", d.Id(), snapshotID, err, e)
+				}
+
+				for k, msg := range detailedErr {
+					return diag.Errorf("Unable to revert %s share to %s snapshot: %s (%d): %s", d.Id(), snapshotID, k, msg.Code, msg.Message)
+				}
+
+				return diag.Errorf("Unable to revert %s share to %s snapshot: %s", d.Id(), snapshotID, err)
+			}
+
+			// Wait for share to become active before continuing
+			err = waitForSFV2Share(ctx, sfsClient, d.Id(), "available", []string{"reverting"}, timeout)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	return resourceSharedFilesystemShareV2Read(ctx, d, meta)
 }
 
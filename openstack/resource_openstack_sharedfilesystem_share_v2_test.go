@@ -30,6 +30,9 @@ func TestAccSFSV2Share_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "name", "nfs_share"),
 					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "description", "test share description"),
 					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "share_proto", "NFS"),
+					resource.TestCheckResourceAttrSet("openstack_sharedfilesystem_share_v2.share_1", "preferred_export_location"),
+					resource.TestCheckResourceAttrSet("openstack_sharedfilesystem_share_v2.share_1", "export_locations.0.id"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "export_locations.0.is_admin_only", "false"),
 				),
 			},
 			{
@@ -119,6 +122,38 @@ func TestAccSFSV2Share_update(t *testing.T) {
 	})
 }
 
+func TestAccSFSV2Share_revert(t *testing.T) {
+	var share shares.Share
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareConfigRevert,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareExists(t.Context(), "openstack_sharedfilesystem_share_v2.share_1", &share),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "revert_to_snapshot_id", ""),
+				),
+			},
+			{
+				Config: testAccSFSV2ShareConfigRevertUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareExists(t.Context(), "openstack_sharedfilesystem_share_v2.share_1", &share),
+					resource.TestCheckResourceAttrPair("openstack_sharedfilesystem_share_v2.share_1", "revert_to_snapshot_id",
+						"openstack_sharedfilesystem_snapshot_v2.snapshot_1", "id"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "size", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSFSV2Share_admin(t *testing.T) {
 	var share shares.Share
 
@@ -341,6 +376,41 @@ resource "openstack_sharedfilesystem_share_v2" "share_1" {
 }
 `
 
+const testAccSFSV2ShareConfigRevert = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name        = "nfs_share_revert"
+  share_proto = "NFS"
+  share_type  = "dhss_false"
+  size        = 1
+}
+
+resource "openstack_sharedfilesystem_snapshot_v2" "snapshot_1" {
+  name     = "nfs_share_revert_snapshot"
+  share_id = openstack_sharedfilesystem_share_v2.share_1.id
+}
+`
+
+// The snapshot is looked up by name to avoid a dependency cycle between the
+// share and its snapshot.
+const testAccSFSV2ShareConfigRevertUpdate = `
+data "openstack_sharedfilesystem_snapshot_v2" "snapshot_1" {
+  name = "nfs_share_revert_snapshot"
+}
+
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name                  = "nfs_share_revert"
+  share_proto           = "NFS"
+  share_type            = "dhss_false"
+  size                  = 1
+  revert_to_snapshot_id = data.openstack_sharedfilesystem_snapshot_v2.snapshot_1.id
+}
+
+resource "openstack_sharedfilesystem_snapshot_v2" "snapshot_1" {
+  name     = "nfs_share_revert_snapshot"
+  share_id = openstack_sharedfilesystem_share_v2.share_1.id
+}
+`
+
 const testAccSFSV2ShareAdminConfigBasic = `
 resource "openstack_sharedfilesystem_share_v2" "share_1" {
   name             = "nfs_share_admin"
@@ -0,0 +1,237 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/snapshots"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSharedFilesystemSnapshotV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSharedFilesystemSnapshotV2Create,
+		ReadContext:   resourceSharedFilesystemSnapshotV2Read,
+		UpdateContext: resourceSharedFilesystemSnapshotV2Update,
+		DeleteContext: resourceSharedFilesystemSnapshotV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"share_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"share_proto": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"share_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemSnapshotV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = minManilaShareMicroversion
+
+	createOpts := snapshots.CreateOpts{
+		ShareID:     d.Get("share_id").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_sharedfilesystem_snapshot_v2 create options: %#v", createOpts)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	var snapshot *snapshots.Snapshot
+
+	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		snapshot, err = snapshots.Create(ctx, sfsClient, createOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("Error creating openstack_sharedfilesystem_snapshot_v2: %s", err)
+	}
+
+	d.SetId(snapshot.ID)
+
+	stateConf := &retry.StateChangeConf{
+		Target:     []string{"available"},
+		Pending:    []string{"creating"},
+		Refresh:    sharedFilesystemSnapshotV2StateRefreshFunc(ctx, sfsClient, snapshot.ID),
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for openstack_sharedfilesystem_snapshot_v2 %s to become available: %s", snapshot.ID, err)
+	}
+
+	return resourceSharedFilesystemSnapshotV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemSnapshotV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = minManilaShareMicroversion
+
+	snapshot, err := snapshots.Get(ctx, sfsClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_snapshot_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_snapshot_v2 %s: %#v", d.Id(), snapshot)
+
+	d.Set("share_id", snapshot.ShareID)
+	d.Set("name", snapshot.Name)
+	d.Set("description", snapshot.Description)
+	d.Set("project_id", snapshot.ProjectID)
+	d.Set("status", snapshot.Status)
+	d.Set("size", snapshot.Size)
+	d.Set("share_proto", snapshot.ShareProto)
+	d.Set("share_size", snapshot.ShareSize)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceSharedFilesystemSnapshotV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = minManilaShareMicroversion
+
+	var updateOpts snapshots.UpdateOpts
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.DisplayName = &name
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.DisplayDescription = &description
+	}
+
+	if updateOpts != (snapshots.UpdateOpts{}) {
+		log.Printf("[DEBUG] openstack_sharedfilesystem_snapshot_v2 %s update options: %#v", d.Id(), updateOpts)
+
+		_, err = snapshots.Update(ctx, sfsClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_sharedfilesystem_snapshot_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceSharedFilesystemSnapshotV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemSnapshotV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = minManilaShareMicroversion
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+
+	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		err := snapshots.Delete(ctx, sfsClient, d.Id()).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_sharedfilesystem_snapshot_v2"))
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Target:     []string{"deleted"},
+		Pending:    []string{"available", "deleting"},
+		Refresh:    sharedFilesystemSnapshotV2StateRefreshFunc(ctx, sfsClient, d.Id()),
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for openstack_sharedfilesystem_snapshot_v2 %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/snapshots"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSFSV2Snapshot_basic(t *testing.T) {
+	var snapshot snapshots.Snapshot
+
+	resourceName := "openstack_sharedfilesystem_snapshot_v2.snapshot_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2SnapshotDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2SnapshotConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2SnapshotExists(t.Context(), resourceName, &snapshot),
+					resource.TestCheckResourceAttrPair(resourceName, "share_id", "openstack_sharedfilesystem_share_v2.share_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "snapshot_1"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test snapshot description"),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceName, "share_proto", "NFS"),
+					resource.TestCheckResourceAttr(resourceName, "share_size", "1"),
+					resource.TestCheckResourceAttr(resourceName, "size", "1"),
+				),
+			},
+			{
+				Config: testAccSFSV2SnapshotConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2SnapshotExists(t.Context(), resourceName, &snapshot),
+					resource.TestCheckResourceAttr(resourceName, "name", "snapshot_1_updated"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSFSV2SnapshotDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		sfsClient, err := config.SharedfilesystemV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_sharedfilesystem_snapshot_v2" {
+				continue
+			}
+
+			_, err := snapshots.Get(ctx, sfsClient, rs.Primary.ID).Extract()
+			if err == nil {
+				return fmt.Errorf("Manila snapshot still exists: %s", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSFSV2SnapshotExists(ctx context.Context, n string, snapshot *snapshots.Snapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		sfsClient, err := config.SharedfilesystemV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %w", err)
+		}
+
+		sfsClient.Microversion = minManilaShareMicroversion
+
+		found, err := snapshots.Get(ctx, sfsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Snapshot not found")
+		}
+
+		*snapshot = *found
+
+		return nil
+	}
+}
+
+const testAccSFSV2SnapshotConfigBasic = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name        = "nfs_share"
+  share_proto = "NFS"
+  share_type  = "dhss_false"
+  size        = 1
+}
+
+resource "openstack_sharedfilesystem_snapshot_v2" "snapshot_1" {
+  share_id    = openstack_sharedfilesystem_share_v2.share_1.id
+  name        = "snapshot_1"
+  description = "test snapshot description"
+}
+`
+
+const testAccSFSV2SnapshotConfigUpdate = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name        = "nfs_share"
+  share_proto = "NFS"
+  share_type  = "dhss_false"
+  size        = 1
+}
+
+resource "openstack_sharedfilesystem_snapshot_v2" "snapshot_1" {
+  share_id = openstack_sharedfilesystem_share_v2.share_1.id
+  name     = "snapshot_1_updated"
+}
+`
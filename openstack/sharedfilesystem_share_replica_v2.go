@@ -0,0 +1,42 @@
+package openstack
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/replicas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+func sharedFilesystemShareReplicaV2StateRefreshFunc(ctx context.Context, client *gophercloud.ServiceClient, replicaID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		replica, err := replicas.Get(ctx, client, replicaID).Extract()
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return replica, "deleted", nil
+			}
+
+			return nil, "", err
+		}
+
+		return replica, replica.Status, nil
+	}
+}
+
+// sharedFilesystemShareReplicaV2PromoteRefreshFunc reports a replica as
+// promoted once it became the active replica of the share.
+func sharedFilesystemShareReplicaV2PromoteRefreshFunc(ctx context.Context, client *gophercloud.ServiceClient, replicaID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		replica, err := replicas.Get(ctx, client, replicaID).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if replica.Status == "available" && replica.State == "active" {
+			return replica, "promoted", nil
+		}
+
+		return replica, replica.Status, nil
+	}
+}
@@ -0,0 +1,120 @@
+package openstack
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+const sharedFilesystemShareTypeV2DHSS = "driver_handles_share_servers"
+
+// sharedFilesystemShareTypeV2 represents a share type. Gophercloud neither
+// supports retrieving a single share type nor its description.
+type sharedFilesystemShareTypeV2 struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	IsPublic    bool              `json:"share_type_access:is_public"`
+	ExtraSpecs  map[string]string `json:"extra_specs"`
+}
+
+// sharedFilesystemShareTypeV2CreateOpts represents the attributes used when
+// creating a share type.
+type sharedFilesystemShareTypeV2CreateOpts struct {
+	Name        string            `json:"name" required:"true"`
+	Description string            `json:"description,omitempty"`
+	IsPublic    *bool             `json:"share_type_access:is_public,omitempty"`
+	ExtraSpecs  map[string]string `json:"extra_specs" required:"true"`
+}
+
+// sharedFilesystemShareTypeV2UpdateOpts represents the attributes used when
+// updating a share type.
+type sharedFilesystemShareTypeV2UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	IsPublic    *bool   `json:"share_type_access:is_public,omitempty"`
+}
+
+type sharedFilesystemShareTypeV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets the response as a share type.
+func (r sharedFilesystemShareTypeV2Result) Extract() (*sharedFilesystemShareTypeV2, error) {
+	var s struct {
+		ShareType *sharedFilesystemShareTypeV2 `json:"share_type"`
+	}
+
+	err := r.ExtractInto(&s)
+
+	return s.ShareType, err
+}
+
+func sharedFilesystemShareTypeV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts sharedFilesystemShareTypeV2CreateOpts) (r sharedFilesystemShareTypeV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "share_type")
+	if err != nil {
+		r.Err = err
+
+		return
+	}
+
+	resp, err := client.Post(ctx, client.ServiceURL("types"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func sharedFilesystemShareTypeV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (r sharedFilesystemShareTypeV2Result) {
+	resp, err := client.Get(ctx, client.ServiceURL("types", id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+func sharedFilesystemShareTypeV2Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts sharedFilesystemShareTypeV2UpdateOpts) (r sharedFilesystemShareTypeV2Result) {
+	b, err := gophercloud.BuildRequestBody(opts, "share_type")
+	if err != nil {
+		r.Err = err
+
+		return
+	}
+
+	resp, err := client.Put(ctx, client.ServiceURL("types", id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+
+	return
+}
+
+// expandSharedFilesystemShareTypeV2ExtraSpecs merges the required
+// driver_handles_share_servers extra spec into the optional ones.
+func expandSharedFilesystemShareTypeV2ExtraSpecs(raw map[string]any, dhss bool) map[string]string {
+	extraSpecs := expandToMapStringString(raw)
+	extraSpecs[sharedFilesystemShareTypeV2DHSS] = strconv.FormatBool(dhss)
+
+	return extraSpecs
+}
+
+// flattenSharedFilesystemShareTypeV2ExtraSpecs splits the required
+// driver_handles_share_servers extra spec from the optional ones.
+func flattenSharedFilesystemShareTypeV2ExtraSpecs(extraSpecs map[string]string) (map[string]string, bool) {
+	optional := make(map[string]string, len(extraSpecs))
+
+	var dhss bool
+
+	for k, v := range extraSpecs {
+		if k == sharedFilesystemShareTypeV2DHSS {
+			dhss, _ = strconv.ParseBool(v)
+
+			continue
+		}
+
+		optional[k] = v
+	}
+
+	return optional, dhss
+}
@@ -0,0 +1,35 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitExpandSharedFilesystemShareTypeV2ExtraSpecs(t *testing.T) {
+	raw := map[string]any{
+		"snapshot_support": "True",
+	}
+
+	expected := map[string]string{
+		"snapshot_support":             "True",
+		"driver_handles_share_servers": "false",
+	}
+
+	assert.Equal(t, expected, expandSharedFilesystemShareTypeV2ExtraSpecs(raw, false))
+}
+
+func TestUnitFlattenSharedFilesystemShareTypeV2ExtraSpecs(t *testing.T) {
+	extraSpecs := map[string]string{
+		"snapshot_support":             "True",
+		"driver_handles_share_servers": "True",
+	}
+
+	expected := map[string]string{
+		"snapshot_support": "True",
+	}
+
+	actual, dhss := flattenSharedFilesystemShareTypeV2ExtraSpecs(extraSpecs)
+	assert.Equal(t, expected, actual)
+	assert.True(t, dhss)
+}
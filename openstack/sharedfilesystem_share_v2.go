@@ -0,0 +1,74 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/apiversions"
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/shares"
+)
+
+// flattenSharedFilesystemShareV2ExportLocations flattens the export
+// locations of a share. preferred is kept as a string for backward
+// compatibility.
+func flattenSharedFilesystemShareV2ExportLocations(exportLocations []shares.ExportLocation) []map[string]any {
+	res := make([]map[string]any, 0, len(exportLocations))
+
+	for _, v := range exportLocations {
+		res = append(res, map[string]any{
+			"id":            v.ID,
+			"path":          v.Path,
+			"preferred":     strconv.FormatBool(v.Preferred),
+			"is_admin_only": v.IsAdminOnly,
+		})
+	}
+
+	return res
+}
+
+// sharedFilesystemShareV2ReadMicroversion returns the microversion to read a
+// share with. share_group_id is only returned since the share group
+// microversion, which is used whenever the API supports it.
+func sharedFilesystemShareV2ReadMicroversion(ctx context.Context, client *gophercloud.ServiceClient) (string, error) {
+	apiInfo, err := apiversions.Get(ctx, client, "v2").Extract()
+	if err != nil {
+		return "", fmt.Errorf("Unable to query API endpoint for shared filesystem microversions: %w", err)
+	}
+
+	ok, err := compatibleMicroversion("min", sharedFilesystemV2ShareGroupMicroversion, apiInfo.Version)
+	if err != nil {
+		return "", err
+	}
+
+	if ok {
+		return sharedFilesystemV2ShareGroupMicroversion, nil
+	}
+
+	return minManilaShareMicroversion, nil
+}
+
+// sharedFilesystemShareV2PreferredExportLocation returns the path a client
+// should mount the share from: the first preferred export location which is
+// not reserved to administrators, or the first non admin one if the driver
+// doesn't flag any location as preferred.
+func sharedFilesystemShareV2PreferredExportLocation(exportLocations []shares.ExportLocation) string {
+	var fallback string
+
+	for _, v := range exportLocations {
+		if v.IsAdminOnly {
+			continue
+		}
+
+		if v.Preferred {
+			return v.Path
+		}
+
+		if fallback == "" {
+			fallback = v.Path
+		}
+	}
+
+	return fallback
+}
@@ -0,0 +1,111 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/shares"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	thclient "github.com/gophercloud/gophercloud/v2/testhelper/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitFlattenSharedFilesystemShareV2ExportLocations(t *testing.T) {
+	exportLocations := []shares.ExportLocation{
+		{
+			ID:          "a3f1c9e0-6d2b-4a3e-9c41-07bb2d1f1f11",
+			Path:        "10.0.0.10:/shares/share_1",
+			Preferred:   true,
+			IsAdminOnly: false,
+		},
+		{
+			ID:          "b7c2d0e1-1f8a-4c51-8d2a-5e7a0c3b2d22",
+			Path:        "192.168.0.10:/shares/share_1",
+			Preferred:   false,
+			IsAdminOnly: true,
+		},
+	}
+
+	expected := []map[string]any{
+		{
+			"id":            "a3f1c9e0-6d2b-4a3e-9c41-07bb2d1f1f11",
+			"path":          "10.0.0.10:/shares/share_1",
+			"preferred":     "true",
+			"is_admin_only": false,
+		},
+		{
+			"id":            "b7c2d0e1-1f8a-4c51-8d2a-5e7a0c3b2d22",
+			"path":          "192.168.0.10:/shares/share_1",
+			"preferred":     "false",
+			"is_admin_only": true,
+		},
+	}
+
+	assert.Equal(t, expected, flattenSharedFilesystemShareV2ExportLocations(exportLocations))
+}
+
+func TestUnitSharedFilesystemShareV2PreferredExportLocation(t *testing.T) {
+	testCases := []struct {
+		name            string
+		exportLocations []shares.ExportLocation
+		expected        string
+	}{
+		{
+			name:     "no export locations",
+			expected: "",
+		},
+		{
+			name: "preferred location",
+			exportLocations: []shares.ExportLocation{
+				{Path: "10.0.0.10:/shares/share_1"},
+				{Path: "10.0.0.11:/shares/share_1", Preferred: true},
+			},
+			expected: "10.0.0.11:/shares/share_1",
+		},
+		{
+			name: "admin only preferred location",
+			exportLocations: []shares.ExportLocation{
+				{Path: "192.168.0.10:/shares/share_1", Preferred: true, IsAdminOnly: true},
+				{Path: "10.0.0.10:/shares/share_1"},
+			},
+			expected: "10.0.0.10:/shares/share_1",
+		},
+		{
+			name: "only admin locations",
+			exportLocations: []shares.ExportLocation{
+				{Path: "192.168.0.10:/shares/share_1", IsAdminOnly: true},
+			},
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, sharedFilesystemShareV2PreferredExportLocation(tc.exportLocations))
+		})
+	}
+}
+
+func TestUnitSharedFilesystemShareV2ReadMicroversion(t *testing.T) {
+	for maxVersion, expected := range map[string]string{
+		"2.32": minManilaShareMicroversion,
+		"2.55": sharedFilesystemV2ShareGroupMicroversion,
+		"2.79": sharedFilesystemV2ShareGroupMicroversion,
+	} {
+		t.Run(maxVersion, func(t *testing.T) {
+			fakeServer := th.SetupHTTP()
+			defer fakeServer.Teardown()
+
+			fakeServer.Mux.HandleFunc("/v2/", func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"versions": [{"id": "v2.0", "min_version": "2.0", "status": "CURRENT", "version": "%s"}]}`, maxVersion)
+			})
+
+			actual, err := sharedFilesystemShareV2ReadMicroversion(t.Context(), thclient.ServiceClient(fakeServer))
+			require.NoError(t, err)
+			assert.Equal(t, expected, actual)
+		})
+	}
+}
@@ -1,9 +1,15 @@
 package openstack
 
 const (
-	sharedFilesystemV2MinMicroversion               = "2.7"
-	sharedFilesystemV2SharedAccessCephXMicroversion = "2.13"
-	sharedFilesystemV2SharedAccessMinMicroversion   = "2.21"
-	sharedFilesystemV2SecurityServiceOUMicroversion = "2.44"
-	sharedFilesystemV2ShareAccessRulesMicroversion  = "2.45"
+	sharedFilesystemV2MinMicroversion                 = "2.7"
+	sharedFilesystemV2SharedAccessCephXMicroversion   = "2.13"
+	sharedFilesystemV2SharedAccessMinMicroversion     = "2.21"
+	sharedFilesystemV2ShareRevertMicroversion         = "2.27"
+	sharedFilesystemV2SecurityServiceOUMicroversion   = "2.44"
+	sharedFilesystemV2ShareAccessRulesMicroversion    = "2.45"
+	sharedFilesystemV2ShareTypeMicroversion           = "2.41"
+	sharedFilesystemV2ShareTypeUpdateMicroversion     = "2.50"
+	sharedFilesystemV2ShareGroupMicroversion          = "2.55"
+	sharedFilesystemV2ShareReplicaMicroversion        = "2.56"
+	sharedFilesystemV2ShareReplicaQuiesceMicroversion = "2.75"
 )
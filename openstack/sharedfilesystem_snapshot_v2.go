@@ -0,0 +1,25 @@
+package openstack
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/snapshots"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+func sharedFilesystemSnapshotV2StateRefreshFunc(ctx context.Context, client *gophercloud.ServiceClient, snapshotID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		snapshot, err := snapshots.Get(ctx, client, snapshotID).Extract()
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return snapshot, "deleted", nil
+			}
+
+			return nil, "", err
+		}
+
+		return snapshot, snapshot.Status, nil
+	}
+}
@@ -65,7 +65,9 @@ func (opts PortCreateOpts) ToPortCreateMap() (map[string]any, error) {
 // RouterCreateOpts represents the attributes used when creating a new router.
 type RouterCreateOpts struct {
 	routers.CreateOpts
-	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+	EnableDefaultRouteECMP *bool             `json:"enable_default_route_ecmp,omitempty"`
+	EnableDefaultRouteBFD  *bool             `json:"enable_default_route_bfd,omitempty"`
+	ValueSpecs             map[string]string `json:"value_specs,omitempty"`
 }
 
 // ToRouterCreateMap casts a CreateOpts struct to a map.
@@ -74,6 +76,19 @@ func (opts RouterCreateOpts) ToRouterCreateMap() (map[string]any, error) {
 	return BuildRequest(opts, "router")
 }
 
+// RouterUpdateOpts represents the attributes used when updating a router.
+type RouterUpdateOpts struct {
+	routers.UpdateOpts
+	EnableDefaultRouteECMP *bool `json:"enable_default_route_ecmp,omitempty"`
+	EnableDefaultRouteBFD  *bool `json:"enable_default_route_bfd,omitempty"`
+}
+
+// ToRouterUpdateMap casts an UpdateOpts struct to a map.
+// It overrides routers.ToRouterUpdateMap to add the default route fields.
+func (opts RouterUpdateOpts) ToRouterUpdateMap() (map[string]any, error) {
+	return BuildRequest(opts, "router")
+}
+
 // SubnetCreateOpts represents the attributes used when creating a new subnet.
 type SubnetCreateOpts struct {
 	subnets.CreateOpts
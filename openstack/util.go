@@ -2,7 +2,6 @@ package openstack
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -82,19 +81,10 @@ func MapValueSpecs(d *schema.ResourceData) map[string]string {
 }
 
 func checkForRetryableError(err error) *retry.RetryError {
-	var e gophercloud.ErrUnexpectedResponseCode
-
-	ok := errors.As(err, &e)
-	if !ok {
-		return retry.NonRetryableError(err)
-	}
-
-	switch e.Actual {
-	case http.StatusConflict, // 409
-		http.StatusInternalServerError, // 500
-		http.StatusBadGateway,          // 502
-		http.StatusServiceUnavailable,  // 503
-		http.StatusGatewayTimeout:      // 504
+	switch classifyOpenStackError(err) {
+	case openStackErrorRetryable,
+		openStackErrorConflict,
+		openStackErrorCapacity:
 		return retry.RetryableError(err)
 	}
 
@@ -303,6 +293,23 @@ func validateJSONObject(v any, k string) ([]string, []error) {
 	return nil, nil
 }
 
+func validateJSONArray(v any, k string) ([]string, []error) {
+	if v == nil || v.(string) == "" {
+		return nil, []error{fmt.Errorf("%q value must not be empty", k)}
+	}
+
+	var j []any
+
+	s := v.(string)
+
+	err := json.Unmarshal([]byte(s), &j)
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q must be a JSON array: %w", k, err)}
+	}
+
+	return nil, nil
+}
+
 func diffSuppressJSONObject(_, o, n string, _ *schema.ResourceData) bool {
 	if strSliceContains([]string{"{}", ""}, o) &&
 		strSliceContains([]string{"{}", ""}, n) {
@@ -355,3 +362,20 @@ func getOkExists(d *schema.ResourceData, key string) (any, bool) {
 
 	return d.Get(key), true
 }
+
+// updatedAfterStateRefreshFunc wraps refresh for asynchronous actions, which
+// an API accepts before the resource leaves its stable status. Until the
+// update time, that updatedAt returns for the refreshed resource, is after
+// since, the resource is reported with the pending status, so that a wait
+// does not return before the action has started.
+func updatedAfterStateRefreshFunc(refresh retry.StateRefreshFunc, updatedAt func(any) (time.Time, bool), since time.Time, pending string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		v, status, err := refresh()
+
+		if t, ok := updatedAt(v); ok && !t.After(since) {
+			return v, pending, nil
+		}
+
+		return v, status, err
+	}
+}
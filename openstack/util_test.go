@@ -1,7 +1,9 @@
 package openstack
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -116,3 +118,84 @@ func TestUnitparsePairedIDs(t *testing.T) {
 	assert.Equal(t, expectedParentID, actualParentID)
 	assert.Equal(t, expectedChildID, actualChildID)
 }
+
+func TestUnitUpdatedAfterStateRefreshFunc(t *testing.T) {
+	type resource struct {
+		status    string
+		updatedAt time.Time
+	}
+
+	var (
+		current    *resource
+		refreshErr error
+	)
+
+	refresh := func() (any, string, error) {
+		if current == nil {
+			return nil, "", refreshErr
+		}
+
+		return current, current.status, refreshErr
+	}
+
+	updatedAt := func(v any) (time.Time, bool) {
+		r, ok := v.(*resource)
+		if !ok {
+			return time.Time{}, false
+		}
+
+		return r.updatedAt, true
+	}
+
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	wrapped := updatedAfterStateRefreshFunc(refresh, updatedAt, since, "PENDING")
+
+	testCases := []struct {
+		resource      *resource
+		err           error
+		expected      string
+		expectedError bool
+	}{
+		{
+			resource: &resource{status: "ACTIVE", updatedAt: since},
+			expected: "PENDING",
+		},
+		{
+			// The error of the previous action is ignored.
+			resource: &resource{status: "ERROR", updatedAt: since},
+			err:      errors.New("error status"),
+			expected: "PENDING",
+		},
+		{
+			resource: &resource{status: "UPDATING", updatedAt: since.Add(time.Second)},
+			expected: "UPDATING",
+		},
+		{
+			resource: &resource{status: "ACTIVE", updatedAt: since.Add(time.Minute)},
+			expected: "ACTIVE",
+		},
+		{
+			resource:      &resource{status: "ERROR", updatedAt: since.Add(time.Minute)},
+			err:           errors.New("error status"),
+			expected:      "ERROR",
+			expectedError: true,
+		},
+		{
+			err:           errors.New("request failed"),
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		current, refreshErr = tc.resource, tc.err
+
+		_, status, err := wrapped()
+		assert.Equal(t, tc.expected, status)
+
+		if tc.expectedError {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+}